@@ -0,0 +1,86 @@
+package app
+
+import (
+	feeabsante "github.com/CudoVentures/cudos-node/x/feeabs/ante"
+	feeabskeeper "github.com/CudoVentures/cudos-node/x/feeabs/keeper"
+	selfdelegationante "github.com/CudoVentures/cudos-node/x/selfdelegation/ante"
+	selfdelegationkeeper "github.com/CudoVentures/cudos-node/x/selfdelegation/keeper"
+	validatorallowlistante "github.com/CudoVentures/cudos-node/x/validatorallowlist/ante"
+	validatorallowlistkeeper "github.com/CudoVentures/cudos-node/x/validatorallowlist/keeper"
+	wasmgovante "github.com/CudoVentures/cudos-node/x/wasmgov/ante"
+	wasmgovkeeper "github.com/CudoVentures/cudos-node/x/wasmgov/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// HandlerOptions extends the SDK's AnteHandler options with the keepers
+// needed by the Cudos decorators.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	FeeAbsKeeper             *feeabskeeper.Keeper
+	WasmGovKeeper            *wasmgovkeeper.Keeper
+	ValidatorAllowlistKeeper *validatorallowlistkeeper.Keeper
+	SelfDelegationKeeper     *selfdelegationkeeper.Keeper
+}
+
+// NewAnteHandler returns the SDK's default AnteHandler with the fee
+// decorators replaced by ones that also accept fees in the tokens whitelisted
+// by the feeabs module, and with the wasm code upload policy, the validator
+// operator allowlist and the self-delegation floor enforced.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	if options.FeeAbsKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "feeabs keeper is required for ante builder")
+	}
+
+	if options.WasmGovKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "wasmgov keeper is required for ante builder")
+	}
+
+	if options.ValidatorAllowlistKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "validatorallowlist keeper is required for ante builder")
+	}
+
+	if options.SelfDelegationKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "selfdelegation keeper is required for ante builder")
+	}
+
+	var sigGasConsumer = options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewRejectExtensionOptionsDecorator(),
+		feeabsante.NewMempoolFeeDecorator(*options.FeeAbsKeeper),
+		ante.NewValidateBasicDecorator(),
+		wasmgovante.NewUploadDecorator(*options.WasmGovKeeper),
+		validatorallowlistante.NewCreateValidatorDecorator(*options.ValidatorAllowlistKeeper),
+		selfdelegationante.NewFloorDecorator(*options.SelfDelegationKeeper),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		feeabsante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, *options.FeeAbsKeeper),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
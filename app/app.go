@@ -14,8 +14,22 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	appparams "github.com/CudoVentures/cudos-node/app/params"
+	"github.com/CudoVentures/cudos-node/x/addressbook"
+	addressbooktypes "github.com/CudoVentures/cudos-node/x/addressbook/types"
 	"github.com/CudoVentures/cudos-node/x/admin"
 	admintypes "github.com/CudoVentures/cudos-node/x/admin/types"
+	"github.com/CudoVentures/cudos-node/x/feeabs"
+	feeabstypes "github.com/CudoVentures/cudos-node/x/feeabs/types"
+	"github.com/CudoVentures/cudos-node/x/marketplace"
+	marketplacetypes "github.com/CudoVentures/cudos-node/x/marketplace/types"
+	"github.com/CudoVentures/cudos-node/x/nft"
+	nfttypes "github.com/CudoVentures/cudos-node/x/nft/types"
+	"github.com/CudoVentures/cudos-node/x/selfdelegation"
+	selfdelegationtypes "github.com/CudoVentures/cudos-node/x/selfdelegation/types"
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist"
+	validatorallowlisttypes "github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	"github.com/CudoVentures/cudos-node/x/wasmgov"
+	wasmgovtypes "github.com/CudoVentures/cudos-node/x/wasmgov/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,7 +43,8 @@ import (
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 
-	// vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
@@ -134,6 +149,12 @@ func New(
 		gravitytypes.StoreKey,
 		feegrant.StoreKey,
 		group.StoreKey,
+		feeabstypes.StoreKey,
+		nfttypes.StoreKey,
+		marketplacetypes.StoreKey,
+		addressbooktypes.StoreKey,
+		admintypes.StoreKey,
+		validatorallowlisttypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -173,6 +194,7 @@ func New(
 	app.mm = module.NewManager(
 		genutil.NewAppModule(app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
+		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
@@ -187,12 +209,19 @@ func New(
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
 		wasm.NewAppModule(appCodec, &app.wasmKeeper, app.StakingKeeper),
-		admin.NewAppModule(appCodec, app.adminKeeper),
+		admin.NewAppModule(appCodec, app.adminKeeper, app.AccountKeeper, app.BankKeeper, app.DistrKeeper),
 		cudoMint.NewAppModule(appCodec, app.cudoMintKeeper),
 		gravity.NewAppModule(app.GravityKeeper, app.BankKeeper),
 		feegrantmod.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.feegrantKeeper, app.interfaceRegistry),
 		// this line is used by starport scaffolding # stargate/app/appModule
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		feeabs.NewAppModule(appCodec, app.FeeAbsKeeper),
+		wasmgov.NewAppModule(appCodec, app.WasmGovKeeper),
+		nft.NewAppModule(appCodec, app.NftKeeper),
+		marketplace.NewAppModule(appCodec, app.MarketplaceKeeper, app.AccountKeeper),
+		addressbook.NewAppModule(appCodec, app.AddressBookKeeper),
+		validatorallowlist.NewAppModule(appCodec, app.ValidatorAllowlistKeeper),
+		selfdelegation.NewAppModule(appCodec, app.SelfDelegationKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -208,6 +237,7 @@ func New(
 		evidencetypes.ModuleName,
 		stakingtypes.ModuleName,
 		authtypes.ModuleName,
+		vestingtypes.ModuleName,
 		authz.ModuleName,
 		banktypes.ModuleName,
 		govtypes.ModuleName,
@@ -221,14 +251,24 @@ func New(
 		ibctransfertypes.ModuleName,
 		wasmtypes.ModuleName,
 		group.ModuleName,
+		feeabstypes.ModuleName,
+		wasmgovtypes.ModuleName,
+		nfttypes.ModuleName,
+		marketplacetypes.ModuleName,
+		addressbooktypes.ModuleName,
+		validatorallowlisttypes.ModuleName,
+		selfdelegationtypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
+		// jails validators before staking applies the validator set updates
+		selfdelegationtypes.ModuleName,
 		stakingtypes.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
+		vestingtypes.ModuleName,
 		authz.ModuleName,
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
@@ -245,6 +285,12 @@ func New(
 		ibctransfertypes.ModuleName,
 		wasmtypes.ModuleName,
 		group.ModuleName,
+		feeabstypes.ModuleName,
+		wasmgovtypes.ModuleName,
+		nfttypes.ModuleName,
+		marketplacetypes.ModuleName,
+		addressbooktypes.ModuleName,
+		validatorallowlisttypes.ModuleName,
 	)
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -256,6 +302,7 @@ func New(
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
+		vestingtypes.ModuleName,
 		authz.ModuleName,
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
@@ -265,6 +312,8 @@ func New(
 		cudominttypes.ModuleName,
 		crisistypes.ModuleName,
 		gravitytypes.ModuleName, // MUST BE BEFORE GENUTIL!!!!
+		// the gentxs are subject to the self-delegation floor
+		selfdelegationtypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		feegrant.ModuleName,
@@ -278,19 +327,31 @@ func New(
 		upgradetypes.ModuleName,
 		paramstypes.ModuleName,
 		group.ModuleName,
+		feeabstypes.ModuleName,
+		wasmgovtypes.ModuleName,
+		nfttypes.ModuleName,
+		marketplacetypes.ModuleName,
+		addressbooktypes.ModuleName,
+		validatorallowlisttypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.mm.RegisterServices(app.configurator)
 
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			FeegrantKeeper:  app.feegrantKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	anteHandler, err := NewAnteHandler(
+		HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.AccountKeeper,
+				BankKeeper:      app.BankKeeper,
+				FeegrantKeeper:  app.feegrantKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			FeeAbsKeeper:             &app.FeeAbsKeeper,
+			WasmGovKeeper:            &app.WasmGovKeeper,
+			ValidatorAllowlistKeeper: &app.ValidatorAllowlistKeeper,
+			SelfDelegationKeeper:     &app.SelfDelegationKeeper,
 		},
 	)
 	if err != nil {
@@ -1,15 +1,16 @@
 package app
 
 import (
-	"strings"
-
 	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmclient "github.com/CosmWasm/wasmd/x/wasm/client"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 
 	"github.com/CudoVentures/cudos-node/x/admin"
+	adminclient "github.com/CudoVentures/cudos-node/x/admin/client"
 	adminkeeper "github.com/CudoVentures/cudos-node/x/admin/keeper"
+	admintypes "github.com/CudoVentures/cudos-node/x/admin/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -34,7 +35,7 @@ import (
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 
-	// vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -82,6 +83,27 @@ import (
 	cudoMintkeeper "github.com/CudoVentures/cudos-node/x/cudoMint/keeper"
 	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
 
+	"github.com/CudoVentures/cudos-node/x/addressbook"
+	addressbookkeeper "github.com/CudoVentures/cudos-node/x/addressbook/keeper"
+	"github.com/CudoVentures/cudos-node/x/feeabs"
+	feeabskeeper "github.com/CudoVentures/cudos-node/x/feeabs/keeper"
+	feeabstypes "github.com/CudoVentures/cudos-node/x/feeabs/types"
+	"github.com/CudoVentures/cudos-node/x/marketplace"
+	marketplacekeeper "github.com/CudoVentures/cudos-node/x/marketplace/keeper"
+	marketplacetypes "github.com/CudoVentures/cudos-node/x/marketplace/types"
+	"github.com/CudoVentures/cudos-node/x/nft"
+	nftkeeper "github.com/CudoVentures/cudos-node/x/nft/keeper"
+	"github.com/CudoVentures/cudos-node/x/selfdelegation"
+	selfdelegationkeeper "github.com/CudoVentures/cudos-node/x/selfdelegation/keeper"
+	selfdelegationtypes "github.com/CudoVentures/cudos-node/x/selfdelegation/types"
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist"
+	validatorallowlistclient "github.com/CudoVentures/cudos-node/x/validatorallowlist/client"
+	validatorallowlistkeeper "github.com/CudoVentures/cudos-node/x/validatorallowlist/keeper"
+	validatorallowlisttypes "github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	"github.com/CudoVentures/cudos-node/x/wasmgov"
+	wasmgovkeeper "github.com/CudoVentures/cudos-node/x/wasmgov/keeper"
+	wasmgovtypes "github.com/CudoVentures/cudos-node/x/wasmgov/types"
+
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity"
 	gravitykeeper "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -90,37 +112,10 @@ import (
 	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
 )
 
-// We pull these out so we can set them with LDFLAGS in the Makefile
-var (
-	// If EnabledSpecificProposals is "", and this is "true", then enable all x/wasm proposals.
-	// If EnabledSpecificProposals is "", and this is not "true", then disable all x/wasm proposals.
-	ProposalsEnabled = "false"
-	// If set to non-empty string it must be comma-separated list of values that are all a subset
-	// of "EnableAllProposals" (takes precedence over ProposalsEnabled)
-	// https://github.com/CudoVentures/cudos-node/blob/02a54d33ff2c064f3539ae12d75d027d9c665f05/x/wasm/internal/types/proposal.go#L28-L34
-	EnableSpecificProposals = ""
-)
-
-// GetEnabledProposals parses the ProposalsEnabled / EnableSpecificProposals values to
-// produce a list of enabled proposals to pass into wasmd app.
-func GetEnabledProposals() []wasm.ProposalType {
-	if EnableSpecificProposals == "" {
-		if ProposalsEnabled == "true" {
-			return wasm.EnableAllProposals
-		}
-		return wasm.DisableAllProposals
-	}
-	chunks := strings.Split(EnableSpecificProposals, ",")
-	proposals, err := wasm.ConvertToProposals(chunks)
-	if err != nil {
-		panic(err)
-	}
-	return proposals
-}
-
 var (
 	ModuleBasics = module.NewBasicManager(
 		auth.AppModuleBasic{},
+		vesting.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		genutil.AppModuleBasic{},
 		bank.AppModuleBasic{},
@@ -128,14 +123,18 @@ var (
 		staking.AppModuleBasic{},
 		// mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(
+		gov.NewAppModuleBasic(append(
+			wasmclient.ProposalHandlers,
 			paramsclient.ProposalHandler,
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
-		),
+			adminclient.VestingSpendProposalHandler,
+			adminclient.ClawbackVestingSpendProposalHandler,
+			validatorallowlistclient.UpdateOperatorAllowlistProposalHandler,
+		)...),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
@@ -150,6 +149,13 @@ var (
 		feegrantmod.AppModuleBasic{},
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 		groupmodule.AppModuleBasic{},
+		feeabs.AppModuleBasic{},
+		wasmgov.AppModuleBasic{},
+		nft.AppModuleBasic{},
+		marketplace.AppModuleBasic{},
+		addressbook.AppModuleBasic{},
+		validatorallowlist.AppModuleBasic{},
+		selfdelegation.AppModuleBasic{},
 	)
 
 	maccPerms = map[string][]string{
@@ -162,10 +168,15 @@ var (
 		cudoMinttypes.ModuleName:       {authtypes.Minter},
 		gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		wasmtypes.ModuleName:           {authtypes.Burner},
+		feeabstypes.ModuleName:         nil,
+		marketplacetypes.ModuleName:    nil,
+		admintypes.ModuleName:          nil,
 	}
 
 	allowedReceivingModAcc = map[string]bool{
 		distrtypes.ModuleName: true,
+		// the feeabs reserve is funded with plain sends
+		feeabstypes.ModuleName: true,
 	}
 )
 
@@ -213,7 +224,15 @@ type App struct {
 	feegrantKeeper feegrantkeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
-	GroupKeeper groupkeeper.Keeper
+	GroupKeeper       groupkeeper.Keeper
+	FeeAbsKeeper      feeabskeeper.Keeper
+	WasmGovKeeper     wasmgovkeeper.Keeper
+	NftKeeper         nftkeeper.Keeper
+	MarketplaceKeeper marketplacekeeper.Keeper
+	AddressBookKeeper addressbookkeeper.Keeper
+
+	ValidatorAllowlistKeeper validatorallowlistkeeper.Keeper
+	SelfDelegationKeeper     selfdelegationkeeper.Keeper
 	// the module manager
 	mm           *module.Manager
 	configurator module.Configurator
@@ -370,6 +389,11 @@ func InitParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(authz.ModuleName)
 	paramsKeeper.Subspace(feegrant.ModuleName)
 	paramsKeeper.Subspace(group.ModuleName)
+	paramsKeeper.Subspace(feeabstypes.ModuleName)
+	paramsKeeper.Subspace(wasmgovtypes.ModuleName)
+	paramsKeeper.Subspace(marketplacetypes.ModuleName)
+	paramsKeeper.Subspace(validatorallowlisttypes.ModuleName)
+	paramsKeeper.Subspace(selfdelegationtypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -4,7 +4,9 @@ import (
 	"path/filepath"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 
+	"github.com/CudoVentures/cudos-node/x/admin"
 	adminkeeper "github.com/CudoVentures/cudos-node/x/admin/keeper"
 	admintypes "github.com/CudoVentures/cudos-node/x/admin/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -53,6 +55,23 @@ import (
 	cudoMintkeeper "github.com/CudoVentures/cudos-node/x/cudoMint/keeper"
 	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
 
+	addressbookkeeper "github.com/CudoVentures/cudos-node/x/addressbook/keeper"
+	addressbooktypes "github.com/CudoVentures/cudos-node/x/addressbook/types"
+	feeabskeeper "github.com/CudoVentures/cudos-node/x/feeabs/keeper"
+	feeabstypes "github.com/CudoVentures/cudos-node/x/feeabs/types"
+	marketplacekeeper "github.com/CudoVentures/cudos-node/x/marketplace/keeper"
+	marketplacetypes "github.com/CudoVentures/cudos-node/x/marketplace/types"
+	nftkeeper "github.com/CudoVentures/cudos-node/x/nft/keeper"
+	nfttypes "github.com/CudoVentures/cudos-node/x/nft/types"
+	selfdelegationkeeper "github.com/CudoVentures/cudos-node/x/selfdelegation/keeper"
+	selfdelegationtypes "github.com/CudoVentures/cudos-node/x/selfdelegation/types"
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist"
+	validatorallowlistkeeper "github.com/CudoVentures/cudos-node/x/validatorallowlist/keeper"
+	validatorallowlisttypes "github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	"github.com/CudoVentures/cudos-node/x/wasmgov"
+	wasmgovkeeper "github.com/CudoVentures/cudos-node/x/wasmgov/keeper"
+	wasmgovtypes "github.com/CudoVentures/cudos-node/x/wasmgov/types"
+
 	gravitykeeper "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 
@@ -110,12 +129,38 @@ func (app *App) AddKeepers(skipUpgradeHeights map[int64]bool, homePath string, a
 	)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, app.keys[upgradetypes.StoreKey], app.appCodec, homePath, app.BaseApp)
 
+	groupConfig := group.DefaultConfig()
+	app.GroupKeeper = groupkeeper.NewKeeper(
+		app.keys[group.StoreKey],
+		app.appCodec,
+		app.MsgServiceRouter(),
+		app.AccountKeeper,
+		groupConfig,
+	)
+
+	app.adminKeeper = *adminkeeper.NewKeeper(
+		app.appCodec, app.keys[admintypes.StoreKey], app.keys[admintypes.MemStoreKey],
+		app.DistrKeeper, app.BankKeeper, app.GroupKeeper,
+	)
+
+	// Only the operators on the allowlist may create validators, listed by admins or governance
+	app.ValidatorAllowlistKeeper = *validatorallowlistkeeper.NewKeeper(
+		app.appCodec,
+		app.keys[validatorallowlisttypes.StoreKey],
+		app.GetSubspace(validatorallowlisttypes.ModuleName),
+		app.adminKeeper,
+		&stakingKeeper,
+	)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.ValidatorAllowlistKeeper.Hooks()),
 	)
 
+	// The chain wide minimum self-delegation is a param of the selfdelegation module
+	app.SelfDelegationKeeper = selfdelegationkeeper.NewKeeper(app.GetSubspace(selfdelegationtypes.ModuleName), app.StakingKeeper)
+
 	app.feegrantKeeper = feegrantkeeper.NewKeeper(app.appCodec, app.keys[feegrant.StoreKey], app.AccountKeeper)
 
 	// Create IBC Keeper
@@ -131,6 +176,9 @@ func (app *App) AddKeepers(skipUpgradeHeights map[int64]bool, homePath string, a
 
 	supportedFeatures := "iterator,staking,stargate"
 
+	// The wasm proposal types and the code upload policy are params of the wasmgov module
+	app.WasmGovKeeper = wasmgovkeeper.NewKeeper(app.GetSubspace(wasmgovtypes.ModuleName), app.GetSubspace(wasm.ModuleName))
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	app.wasmKeeper = wasm.NewKeeper(
@@ -150,26 +198,28 @@ func (app *App) AddKeepers(skipUpgradeHeights map[int64]bool, homePath string, a
 		wasmDir,
 		wasmConfig,
 		supportedFeatures,
+		wasmkeeper.WithMessageHandlerDecorator(app.WasmGovKeeper.MessageHandlerDecorator(app.appCodec)),
 	)
 
-	app.adminKeeper = *adminkeeper.NewKeeper(
-		app.appCodec, app.keys[admintypes.StoreKey], app.keys[admintypes.MemStoreKey],
-		app.DistrKeeper, app.BankKeeper,
-	)
+	// Wasm code lives next to the IAVL stores, so state-sync snapshots carry it as an extension
+	if manager := app.SnapshotManager(); manager != nil {
+		err := manager.RegisterExtensions(NewWasmSnapshotter(app.CommitMultiStore(), &app.wasmKeeper, app.keys[wasm.StoreKey]))
+		if err != nil {
+			panic("failed to register snapshot extension: " + err.Error())
+		}
+	}
 
 	govKeeper := govtypes.NewRouter()
 
-	// The gov proposal types can be individually enabled
-	if len(GetEnabledProposals()) != 0 {
-		govKeeper.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.wasmKeeper, GetEnabledProposals()))
-	}
-
 	// register the proposal types
 	govKeeper.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(wasm.RouterKey, wasmgov.NewWasmProposalHandler(app.WasmGovKeeper, wasmkeeper.NewGovPermissionKeeper(app.wasmKeeper))).
+		AddRoute(paramproposal.RouterKey, wasmgov.NewParamChangeProposalHandler(app.WasmGovKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(admintypes.RouterKey, admin.NewVestingSpendProposalHandler(app.adminKeeper)).
+		AddRoute(validatorallowlisttypes.RouterKey, validatorallowlist.NewOperatorAllowlistProposalHandler(app.ValidatorAllowlistKeeper))
 
 	app.GovKeeper = govkeeper.NewKeeper(
 		app.appCodec, app.keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
@@ -195,20 +245,45 @@ func (app *App) AddKeepers(skipUpgradeHeights map[int64]bool, homePath string, a
 		app.keys[cudoMinttypes.MemStoreKey],
 		app.BankKeeper,
 		app.AccountKeeper,
+		app.DistrKeeper,
+		app.StakingKeeper,
 		app.GetSubspace(cudoMinttypes.ModuleName),
 		authtypes.FeeCollectorName,
+		app.ModuleAccountAddrs(),
 	)
 
 	app.GravityKeeper = gravitykeeper.NewKeeper(
 		app.appCodec, app.keys[gravitytypes.StoreKey], app.GetSubspace(gravitytypes.ModuleName), stakingKeeper, app.BankKeeper, app.SlashingKeeper, app.AccountKeeper,
 	)
 
-	groupConfig := group.DefaultConfig()
-	app.GroupKeeper = groupkeeper.NewKeeper(
-		app.keys[group.StoreKey],
+	app.FeeAbsKeeper = *feeabskeeper.NewKeeper(
 		app.appCodec,
-		app.MsgServiceRouter(),
+		app.keys[feeabstypes.StoreKey],
+		app.GetSubspace(feeabstypes.ModuleName),
 		app.AccountKeeper,
-		groupConfig,
+		app.BankKeeper,
+		app.DistrKeeper,
+		app.adminKeeper,
+		authtypes.FeeCollectorName,
+	)
+
+	app.NftKeeper = *nftkeeper.NewKeeper(
+		app.appCodec,
+		app.keys[nfttypes.StoreKey],
+	)
+
+	app.MarketplaceKeeper = *marketplacekeeper.NewKeeper(
+		app.appCodec,
+		app.keys[marketplacetypes.StoreKey],
+		app.GetSubspace(marketplacetypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		app.NftKeeper,
+	)
+
+	app.AddressBookKeeper = *addressbookkeeper.NewKeeper(
+		app.appCodec,
+		app.keys[addressbooktypes.StoreKey],
 	)
 }
@@ -1,29 +1,201 @@
 package app
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 
+	addressbooktypes "github.com/CudoVentures/cudos-node/x/addressbook/types"
+	admintypes "github.com/CudoVentures/cudos-node/x/admin/types"
+	cudoMintv1 "github.com/CudoVentures/cudos-node/x/cudoMint/legacy/v1"
 	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	feeabstypes "github.com/CudoVentures/cudos-node/x/feeabs/types"
+	marketplacetypes "github.com/CudoVentures/cudos-node/x/marketplace/types"
+	nfttypes "github.com/CudoVentures/cudos-node/x/nft/types"
+	selfdelegationtypes "github.com/CudoVentures/cudos-node/x/selfdelegation/types"
+	validatorallowlisttypes "github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	wasmgovtypes "github.com/CudoVentures/cudos-node/x/wasmgov/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/group"
 
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
-const AddressBookModuleName = "addressbook"
-const NftModuleName = "nft"
-const MarketplaceModuleName = "marketplace"
+const AddressBookModuleName = addressbooktypes.ModuleName
+const NftModuleName = nfttypes.ModuleName
+const MarketplaceModuleName = marketplacetypes.ModuleName
+
+// Upgrade describes a software upgrade: the stores it adds, deletes or renames
+// and the handler that migrates the state once the upgrade height is reached.
+type Upgrade struct {
+	Name string
+
+	// StoreUpgrades is applied by the store loader at the upgrade height.
+	StoreUpgrades storetypes.StoreUpgrades
+
+	// NewModules lists the modules introduced by the upgrade that have no
+	// store of their own, the others are taken from StoreUpgrades.Added.
+	NewModules []string
+
+	// ExistingModuleStores lists the stores of StoreUpgrades.Added that belong
+	// to modules the chain already runs, so they are not new modules.
+	ExistingModuleStores []string
+
+	// CreateHandler returns the upgrade handler. Upgrades without one only
+	// run the module migrations.
+	CreateHandler func(app *App, upgrade Upgrade) upgradetypes.UpgradeHandler
+
+	// MigrateGenesis converts the genesis exported before the upgrade, the
+	// same way the handler converts the state. Modules introduced by the
+	// upgrade get their default genesis without it.
+	MigrateGenesis func(cdc codec.JSONCodec, appState GenesisState) error
+}
+
+// Upgrades lists every upgrade known to the binary, oldest first.
+var Upgrades []Upgrade
+
+// Upgrades is set in init since handlers look up the upgrades preceding them.
+func init() {
+	Upgrades = []Upgrade{
+		{
+			Name:           "v1.0",
+			CreateHandler:  createHandlerForVersion_1_0,
+			MigrateGenesis: migrateGenesisForVersion_1_0,
+		},
+		{
+			Name: "v1.1",
+			// the v1.1 binary did not mount the nft, addressbook and
+			// marketplace stores, so they were never created and v1.2 adds them
+			StoreUpgrades: storetypes.StoreUpgrades{
+				Added: []string{authz.ModuleName, group.ModuleName},
+			},
+			CreateHandler: createHandlerForVersion_1_1,
+		},
+		{
+			// The store loader only applies the store upgrades of the plan
+			// being run, so every store and module the binary introduces
+			// since v1.1 is added by this one upgrade.
+			Name: "v1.2",
+			StoreUpgrades: storetypes.StoreUpgrades{
+				Added: []string{
+					feeabstypes.StoreKey, nfttypes.StoreKey, marketplacetypes.StoreKey, addressbooktypes.StoreKey,
+					admintypes.StoreKey, validatorallowlisttypes.StoreKey,
+				},
+			},
+			// the admin module keeps its vesting spends in a store now
+			ExistingModuleStores: []string{admintypes.StoreKey},
+			// the validatorallowlist genesis allows the operators of the
+			// running validators
+			NewModules:     []string{wasmgovtypes.ModuleName, vestingtypes.ModuleName, selfdelegationtypes.ModuleName},
+			MigrateGenesis: migrateGenesisForVersion_1_2,
+		},
+	}
+}
 
 func (app *App) SetUpgradeHandlers() {
-	setHandlerForVersion_1_0(app)
-	setHandlerForVersion_1_1(app)
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(upgrade.Name, upgrade.handler(app))
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgrade.Name == upgradeInfo.Name {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+}
+
+// handler returns the upgrade handler, running the module migrations when
+// the upgrade does not define one.
+func (u Upgrade) handler(app *App) upgradetypes.UpgradeHandler {
+	if u.CreateHandler != nil {
+		return u.CreateHandler(app, u)
+	}
+
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	}
+}
+
+// Modules returns the modules introduced by the upgrade.
+func (u Upgrade) Modules() []string {
+	existing := make(map[string]bool, len(u.ExistingModuleStores))
+	for _, store := range u.ExistingModuleStores {
+		existing[store] = true
+	}
+
+	var modules []string
+	for _, store := range u.StoreUpgrades.Added {
+		if !existing[store] {
+			modules = append(modules, store)
+		}
+	}
+	return append(modules, u.NewModules...)
+}
+
+// MigrateGenesis converts a genesis exported by an older binary to the state
+// of this binary by applying the genesis migrations of all upgrades. The
+// migrations leave state that is already converted untouched, so the version
+// of the export does not need to be known.
+func MigrateGenesis(cdc codec.JSONCodec, appState GenesisState) (GenesisState, error) {
+	for _, upgrade := range Upgrades {
+		if upgrade.MigrateGenesis != nil {
+			if err := upgrade.MigrateGenesis(cdc, appState); err != nil {
+				return nil, fmt.Errorf("migrating genesis to %s: %w", upgrade.Name, err)
+			}
+		}
+
+		for _, moduleName := range upgrade.Modules() {
+			module, ok := ModuleBasics[moduleName]
+			if _, exists := appState[moduleName]; ok && !exists {
+				appState[moduleName] = module.DefaultGenesis(cdc)
+			}
+		}
+	}
+
+	return appState, nil
+}
+
+// VersionMapBefore returns the module versions of the chain right before the
+// named upgrade: the modules of the binary without the ones introduced by
+// that upgrade or any later one.
+func (app *App) VersionMapBefore(name string) (module.VersionMap, error) {
+	fromVM := app.mm.GetVersionMap()
+
+	found := false
+	for _, upgrade := range Upgrades {
+		found = found || upgrade.Name == name
+		if !found {
+			continue
+		}
+
+		for _, moduleName := range upgrade.Modules() {
+			delete(fromVM, moduleName)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("unknown upgrade %s", name)
+	}
+	return fromVM, nil
 }
 
-func setHandlerForVersion_1_0(app *App) {
-	app.UpgradeKeeper.SetUpgradeHandler("v1.0", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+func createHandlerForVersion_1_0(app *App, _ Upgrade) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		ss, ok := app.ParamsKeeper.GetSubspace(cudoMinttypes.ModuleName)
 		if ok {
 			bpd := ss.GetRaw(ctx, []byte("BlocksPerDay"))
@@ -37,42 +209,87 @@ func setHandlerForVersion_1_0(app *App) {
 		}
 
 		return fromVM, nil
-	})
+	}
 }
 
-func setHandlerForVersion_1_1(app *App) {
-	const upgradeVersion string = "v1.1"
+func migrateGenesisForVersion_1_0(cdc codec.JSONCodec, appState GenesisState) error {
+	bz, ok := appState[cudoMinttypes.ModuleName]
+	if !ok {
+		return nil
+	}
+
+	var params struct {
+		Params map[string]json.RawMessage `json:"params"`
+	}
+	if err := json.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+	if _, legacy := params.Params["blocks_per_day"]; !legacy {
+		return nil
+	}
 
-	app.UpgradeKeeper.SetUpgradeHandler(upgradeVersion, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+	var oldGenState cudoMintv1.GenesisState
+	if err := json.Unmarshal(bz, &oldGenState); err != nil {
+		return err
+	}
+
+	newGenState := cudoMintv1.Migrate(oldGenState)
+	if err := newGenState.Validate(); err != nil {
+		return err
+	}
+
+	appState[cudoMinttypes.ModuleName] = cdc.MustMarshalJSON(newGenState)
+	return nil
+}
+
+func createHandlerForVersion_1_1(app *App, upgrade Upgrade) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// v1.0 chains have no module versions stored yet
 		if len(fromVM) == 0 {
-			fromVM = app.mm.GetVersionMap()
-			delete(fromVM, authz.ModuleName)
-			delete(fromVM, group.ModuleName)
-			delete(fromVM, AddressBookModuleName)
-			delete(fromVM, MarketplaceModuleName)
-
-			if _, ok := fromVM[NftModuleName]; ok {
-				if fromVM[NftModuleName] == 2 {
-					fromVM[NftModuleName] = 1
-				}
-			} else {
+			var err error
+			if fromVM, err = app.VersionMapBefore(upgrade.Name); err != nil {
+				return nil, err
+			}
+
+			if fromVM[NftModuleName] == 0 || fromVM[NftModuleName] == 2 {
 				fromVM[NftModuleName] = 1
 			}
 		}
 
 		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
-	})
+	}
+}
 
-	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
-	if err != nil {
-		panic(err)
+// migrateGenesisForVersion_1_2 adds the cudoMint staking feedback params,
+// disabled, and the emission carry, empty, as the cudoMint migration does.
+func migrateGenesisForVersion_1_2(cdc codec.JSONCodec, appState GenesisState) error {
+	bz, ok := appState[cudoMinttypes.ModuleName]
+	if !ok {
+		return nil
 	}
 
-	if upgradeInfo.Name == upgradeVersion && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{authz.ModuleName, group.ModuleName, AddressBookModuleName, MarketplaceModuleName},
-		}
+	var genState cudoMinttypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return err
+	}
 
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	defaults := cudoMinttypes.DefaultParams()
+	if genState.Params.GoalBonded.IsNil() {
+		genState.Params.GoalBonded = defaults.GoalBonded
+	}
+	if genState.Params.EmissionFactorMin.IsNil() {
+		genState.Params.EmissionFactorMin = defaults.EmissionFactorMin
 	}
+	if genState.Params.EmissionFactorMax.IsNil() {
+		genState.Params.EmissionFactorMax = defaults.EmissionFactorMax
+	}
+	if genState.Minter.EmissionCarry.IsNil() {
+		genState.Minter.EmissionCarry = sdk.ZeroInt()
+	}
+	if err := genState.Validate(); err != nil {
+		return err
+	}
+
+	appState[cudoMinttypes.ModuleName] = cdc.MustMarshalJSON(&genState)
+	return nil
 }
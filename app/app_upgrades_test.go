@@ -0,0 +1,443 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	addressbooktypes "github.com/CudoVentures/cudos-node/x/addressbook/types"
+	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	nfttypes "github.com/CudoVentures/cudos-node/x/nft/types"
+	validatorallowlisttypes "github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	ibchost "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+)
+
+const upgradeGenesisFixture = "testdata/upgrade_genesis.json"
+
+func TestMain(m *testing.M) {
+	SetConfig()
+	os.Exit(m.Run())
+}
+
+type emptyAppOptions struct{}
+
+func (emptyAppOptions) Get(string) interface{} { return nil }
+
+// setupUpgradeApp starts a chain from the exported genesis fixture as it
+// would have looked right before the named upgrade.
+func setupUpgradeApp(t *testing.T, name string, db dbm.DB, baseAppOptions ...func(*baseapp.BaseApp)) (*App, module.VersionMap) {
+	genDoc, err := tmtypes.GenesisDocFromFile(upgradeGenesisFixture)
+	require.NoError(t, err)
+
+	app := New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), emptyAppOptions{}, baseAppOptions...)
+
+	fromVM, err := app.VersionMapBefore(name)
+	require.NoError(t, err)
+
+	// modules introduced by the upgrade start out with empty stores
+	var appState GenesisState
+	require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+	for moduleName := range app.mm.GetVersionMap() {
+		if _, ok := fromVM[moduleName]; !ok {
+			delete(appState, moduleName)
+		}
+	}
+	appStateBytes, err := json.Marshal(appState)
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         genDoc.ChainID,
+		AppStateBytes:   appStateBytes,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+	})
+	app.Commit()
+
+	return app, fromVM
+}
+
+func TestUpgradeRegistry(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), emptyAppOptions{})
+
+	names := make(map[string]bool)
+	added := make(map[string]string)
+	for _, upgrade := range Upgrades {
+		require.False(t, names[upgrade.Name], "duplicate upgrade %s", upgrade.Name)
+		names[upgrade.Name] = true
+
+		for _, moduleName := range upgrade.Modules() {
+			require.Empty(t, added[moduleName], "module %s added by %s and %s", moduleName, added[moduleName], upgrade.Name)
+			added[moduleName] = upgrade.Name
+		}
+		for _, store := range upgrade.ExistingModuleStores {
+			require.Contains(t, upgrade.StoreUpgrades.Added, store, "store %s of %s is not added", store, upgrade.Name)
+		}
+		for _, store := range upgrade.StoreUpgrades.Deleted {
+			require.Nil(t, app.keys[store], "store %s deleted by %s is still mounted", store, upgrade.Name)
+		}
+		for _, rename := range upgrade.StoreUpgrades.Renamed {
+			require.NotNil(t, app.keys[rename.NewKey], "store %s renamed by %s is not mounted", rename.NewKey, upgrade.Name)
+		}
+	}
+}
+
+func TestUpgrades(t *testing.T) {
+	for _, upgrade := range Upgrades {
+		upgrade := upgrade
+		t.Run(upgrade.Name, func(t *testing.T) {
+			app, fromVM := setupUpgradeApp(t, upgrade.Name, dbm.NewMemDB())
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+			ctx := app.BaseApp.NewContext(false, header)
+
+			plan := upgradetypes.Plan{Name: upgrade.Name, Height: ctx.BlockHeight()}
+			toVM, err := upgrade.handler(app)(ctx, plan, fromVM)
+			require.NoError(t, err)
+
+			// the modules introduced by the upgrade end up at the binary's versions
+			versions := app.mm.GetVersionMap()
+			for _, moduleName := range upgrade.Modules() {
+				if version, ok := versions[moduleName]; ok {
+					require.Equal(t, version, toVM[moduleName], moduleName)
+				}
+			}
+
+			app.CrisisKeeper.AssertInvariants(ctx)
+		})
+	}
+}
+
+func TestSimulateUpgrade(t *testing.T) {
+	db := dbm.NewMemDB()
+	setupUpgradeApp(t, "v1.0", db)
+
+	app := New(log.NewNopLogger(), db, nil, false, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), emptyAppOptions{})
+	simulation, err := app.SimulateUpgrade("v1.0")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), simulation.Height)
+	require.NotEmpty(t, simulation.Modules)
+	for _, invariant := range simulation.Invariants {
+		require.False(t, invariant.Broken, invariant.Message)
+	}
+
+	// nothing was committed
+	app = New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), emptyAppOptions{})
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	require.Equal(t, int64(1), app.LastBlockHeight())
+	require.Zero(t, app.UpgradeKeeper.GetDoneHeight(ctx, "v1.0"))
+
+	_, err = app.SimulateUpgrade("unknown")
+	require.Error(t, err)
+}
+
+func TestSimulateUpgradeAddingStores(t *testing.T) {
+	db, home := setupV1_1Chain(t, "v1.2")
+
+	app := New(log.NewNopLogger(), db, nil, false, map[int64]bool{}, home, 0, MakeEncodingConfig(), emptyAppOptions{})
+	simulation, err := app.SimulateUpgrade("v1.2")
+	require.NoError(t, err)
+
+	diffs := make(map[string]StoreDiff)
+	for _, diff := range simulation.StoreDiffs {
+		diffs[diff.Store] = diff
+	}
+
+	// the upgrade clears the plan and records that it was done
+	require.Equal(t, 1, diffs[upgradetypes.StoreKey].Deleted)
+	require.NotZero(t, diffs[upgradetypes.StoreKey].Added)
+
+	// the genesis of the added validatorallowlist store is all new
+	require.NotZero(t, diffs[validatorallowlisttypes.StoreKey].Added)
+	require.Zero(t, diffs[validatorallowlisttypes.StoreKey].Updated)
+	require.Zero(t, diffs[validatorallowlisttypes.StoreKey].Deleted)
+}
+
+func TestMigrateGenesis(t *testing.T) {
+	genDoc, err := tmtypes.GenesisDocFromFile(upgradeGenesisFixture)
+	require.NoError(t, err)
+
+	var appState GenesisState
+	require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+
+	// a v1.0 export: legacy cudoMint params and none of the later modules
+	appState[cudoMinttypes.ModuleName] = json.RawMessage(`{
+		"minter": {"mint_remainder": "0.000000000000000000", "norm_time_passed": "0.600000000000000000"},
+		"params": {"blocks_per_day": "14400"}
+	}`)
+	for _, upgrade := range Upgrades[1:] {
+		for _, moduleName := range upgrade.Modules() {
+			delete(appState, moduleName)
+		}
+	}
+
+	encCfg := MakeEncodingConfig()
+	migrated, err := MigrateGenesis(encCfg.Codec, appState)
+	require.NoError(t, err)
+
+	var cudoMintGenState cudoMinttypes.GenesisState
+	encCfg.Codec.MustUnmarshalJSON(migrated[cudoMinttypes.ModuleName], &cudoMintGenState)
+	require.Equal(t, sdk.NewInt(14400), cudoMintGenState.Params.IncrementModifier)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), cudoMintGenState.Minter.NormTimePassed)
+
+	// every module of the binary has a genesis it accepts
+	for moduleName := range ModuleBasics {
+		require.Contains(t, migrated, moduleName)
+	}
+	require.NoError(t, ModuleBasics.ValidateGenesis(encCfg.Codec, encCfg.TxConfig, migrated))
+
+	// the migrations leave converted state untouched
+	again, err := MigrateGenesis(encCfg.Codec, migrated)
+	require.NoError(t, err)
+	require.JSONEq(t, string(migrated[cudoMinttypes.ModuleName]), string(again[cudoMinttypes.ModuleName]))
+}
+
+func TestMigrateGenesisV1_2AddsStakingFeedback(t *testing.T) {
+	genDoc, err := tmtypes.GenesisDocFromFile(upgradeGenesisFixture)
+	require.NoError(t, err)
+
+	var appState GenesisState
+	require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+
+	// a v1.1 export, without the excluded addresses and the staking feedback
+	appState[cudoMinttypes.ModuleName] = json.RawMessage(`{
+		"minter": {"mint_remainder": "0.000000000000000000", "norm_time_passed": "0.600000000000000000"},
+		"params": {"increment_modifier": "17280"}
+	}`)
+
+	encCfg := MakeEncodingConfig()
+	migrated, err := MigrateGenesis(encCfg.Codec, appState)
+	require.NoError(t, err)
+
+	var cudoMintGenState cudoMinttypes.GenesisState
+	encCfg.Codec.MustUnmarshalJSON(migrated[cudoMinttypes.ModuleName], &cudoMintGenState)
+	require.Equal(t, cudoMinttypes.DefaultParams(), cudoMintGenState.Params)
+	require.Equal(t, sdk.ZeroInt(), cudoMintGenState.Minter.EmissionCarry)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), cudoMintGenState.Minter.NormTimePassed)
+}
+
+func TestUpgradeV1_1MigratesNft(t *testing.T) {
+	app, _ := setupUpgradeApp(t, "v1.1", dbm.NewMemDB())
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	// a version 1 collection, without the supply of version 2
+	owner := sdk.AccAddress([]byte("owner_______________")).String()
+	require.NoError(t, app.NftKeeper.IssueDenom(ctx, nfttypes.Denom{Id: "artworks", Name: "Artworks", Creator: owner}))
+	require.NoError(t, app.NftKeeper.MintNFT(ctx, "artworks", nfttypes.BaseNFT{Id: "a1", Owner: owner}))
+	ctx.KVStore(app.keys[nfttypes.StoreKey]).Delete(nfttypes.SupplyKey("artworks"))
+
+	// v1.0 chains store no module versions
+	plan := upgradetypes.Plan{Name: "v1.1", Height: ctx.BlockHeight()}
+	toVM, err := Upgrades[1].handler(app)(ctx, plan, module.VersionMap{})
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(2), toVM[NftModuleName])
+	require.Equal(t, uint64(1), app.NftKeeper.GetSupply(ctx, "artworks"))
+	app.CrisisKeeper.AssertInvariants(ctx)
+}
+
+func TestUpgradeV1_2InitsAddressBook(t *testing.T) {
+	app, fromVM := setupUpgradeApp(t, "v1.2", dbm.NewMemDB())
+	require.NotContains(t, fromVM, addressbooktypes.ModuleName)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	plan := upgradetypes.Plan{Name: "v1.2", Height: ctx.BlockHeight()}
+	toVM, err := Upgrades[2].handler(app)(ctx, plan, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), toVM[addressbooktypes.ModuleName])
+
+	var genState addressbooktypes.GenesisState
+	app.appCodec.MustUnmarshalJSON(app.mm.Modules[addressbooktypes.ModuleName].ExportGenesis(ctx, app.appCodec), &genState)
+	require.Empty(t, genState.Addresses)
+}
+
+// v1_1StoreKeys are the stores mounted by the v1.1 binary.
+var v1_1StoreKeys = []string{
+	authtypes.StoreKey, authz.ModuleName, banktypes.StoreKey, stakingtypes.StoreKey, distrtypes.StoreKey,
+	slashingtypes.StoreKey, govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
+	evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey, cudoMinttypes.StoreKey,
+	wasm.StoreKey, gravitytypes.StoreKey, feegrant.StoreKey, group.StoreKey,
+}
+
+// setupV1_1Chain writes the state of a v1.1 chain that scheduled the named
+// upgrade for its next block into the stores mounted by the v1.1 binary, and
+// the upgrade info the v1.1 binary dumps when it halts for the upgrade.
+func setupV1_1Chain(t *testing.T, name string) (dbm.DB, string) {
+	var sourceCMS sdk.CommitMultiStore
+	source, fromVM := setupUpgradeApp(t, name, dbm.NewMemDB(), func(bapp *baseapp.BaseApp) {
+		sourceCMS = bapp.CommitMultiStore()
+	})
+	ctx := sdk.NewContext(sourceCMS, tmproto.Header{}, false, log.NewNopLogger())
+	versionStore := prefix.NewStore(ctx.KVStore(source.keys[upgradetypes.StoreKey]), []byte{upgradetypes.VersionMapByte})
+	for moduleName := range source.mm.GetVersionMap() {
+		versionStore.Delete([]byte(moduleName))
+	}
+	source.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM)
+	plan := upgradetypes.Plan{Name: name, Height: source.LastBlockHeight() + 1}
+	require.NoError(t, source.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	db := dbm.NewMemDB()
+	cms := rootmulti.NewStore(db)
+	keys := sdk.NewKVStoreKeys(v1_1StoreKeys...)
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+	for name, key := range keys {
+		store := cms.GetKVStore(key)
+		iter := sourceCMS.GetKVStore(source.keys[name]).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			store.Set(iter.Key(), iter.Value())
+		}
+		require.NoError(t, iter.Close())
+	}
+	cms.Commit()
+
+	home := t.TempDir()
+	upgradeInfo, err := json.Marshal(storetypes.UpgradeInfo{Name: plan.Name, Height: plan.Height})
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data", upgradekeeper.UpgradeInfoFileName), upgradeInfo, 0o600))
+
+	return db, home
+}
+
+func TestUpgradeV1_2StoreLoader(t *testing.T) {
+	db, home := setupV1_1Chain(t, "v1.2")
+
+	var cms sdk.CommitMultiStore
+	app := New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, MakeEncodingConfig(), emptyAppOptions{}, func(bapp *baseapp.BaseApp) {
+		cms = bapp.CommitMultiStore()
+	})
+	require.Equal(t, int64(1), app.LastBlockHeight())
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now().UTC()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// the stores added by the upgrade start at the upgrade height
+	for name, key := range app.keys {
+		require.Equal(t, header.Height, cms.GetCommitKVStore(key).LastCommitID().Version, name)
+	}
+	_, err := cms.CacheMultiStoreWithVersion(header.Height)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(true, header)
+	require.Equal(t, header.Height, app.UpgradeKeeper.GetDoneHeight(ctx, "v1.2"))
+	require.Equal(t, app.mm.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+	for _, validator := range app.StakingKeeper.GetAllValidators(ctx) {
+		require.True(t, app.ValidatorAllowlistKeeper.IsAllowed(ctx, validator.GetOperator()), validator.OperatorAddress)
+	}
+	app.CrisisKeeper.AssertInvariants(ctx)
+}
+
+func TestUpgradeV1_2AddsNftStore(t *testing.T) {
+	db, home := setupV1_1Chain(t, "v1.2")
+
+	var cms sdk.CommitMultiStore
+	app := New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, MakeEncodingConfig(), emptyAppOptions{}, func(bapp *baseapp.BaseApp) {
+		cms = bapp.CommitMultiStore()
+	})
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now().UTC()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+	owner := sdk.AccAddress([]byte("owner_______________")).String()
+	require.NoError(t, app.NftKeeper.IssueDenom(ctx, nfttypes.Denom{Id: "artworks", Name: "Artworks", Creator: owner}))
+	require.NoError(t, app.NftKeeper.MintNFT(ctx, "artworks", nfttypes.BaseNFT{Id: "a1", Owner: owner}))
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// the nft store starts at the upgrade height and keeps its state
+	require.Equal(t, header.Height, cms.GetCommitKVStore(app.keys[nfttypes.StoreKey]).LastCommitID().Version)
+	app = New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, MakeEncodingConfig(), emptyAppOptions{})
+	ctx = app.BaseApp.NewContext(true, header)
+	nft, err := app.NftKeeper.GetNFT(ctx, "artworks", "a1")
+	require.NoError(t, err)
+	require.Equal(t, owner, nft.GetOwner())
+	require.Equal(t, uint64(1), app.NftKeeper.GetSupply(ctx, "artworks"))
+}
+
+func TestUpgradeV1_2AllowsRunningValidators(t *testing.T) {
+	app, fromVM := setupUpgradeApp(t, "v1.2", dbm.NewMemDB())
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	plan := upgradetypes.Plan{Name: "v1.2", Height: ctx.BlockHeight()}
+	_, err := Upgrades[2].handler(app)(ctx, plan, fromVM)
+	require.NoError(t, err)
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.NotEmpty(t, validators)
+	for _, validator := range validators {
+		require.True(t, app.ValidatorAllowlistKeeper.IsAllowed(ctx, validator.GetOperator()), validator.OperatorAddress)
+	}
+
+	// new operators need to be allowed first
+	newOperator := sdk.ValAddress([]byte("new_operator________"))
+	require.True(t, app.ValidatorAllowlistKeeper.IsEnforced(ctx))
+	require.Error(t, app.ValidatorAllowlistKeeper.CanCreateValidator(ctx, newOperator))
+}
+
+func TestUpgradeV1_2MigratesCudoMint(t *testing.T) {
+	app, fromVM := setupUpgradeApp(t, "v1.2", dbm.NewMemDB())
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	// a version 1 cudoMint, without the excluded addresses and the staking
+	// feedback params
+	store := ctx.KVStore(app.keys[paramstypes.StoreKey])
+	for _, key := range [][]byte{cudoMinttypes.ExcludedAddresses, cudoMinttypes.GoalBonded, cudoMinttypes.EmissionFactorMin, cudoMinttypes.EmissionFactorMax} {
+		store.Delete(append([]byte(cudoMinttypes.ModuleName+"/"), key...))
+	}
+	fromVM[cudoMinttypes.ModuleName] = 1
+
+	plan := upgradetypes.Plan{Name: "v1.2", Height: ctx.BlockHeight()}
+	toVM, err := Upgrades[2].handler(app)(ctx, plan, fromVM)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(3), toVM[cudoMinttypes.ModuleName])
+	params := app.cudoMintKeeper.GetParams(ctx)
+	require.Empty(t, params.ExcludedAddresses)
+	require.False(t, params.StakingFeedbackEnabled())
+	require.Equal(t, cudoMinttypes.DefaultParams().GoalBonded, params.GoalBonded)
+	require.True(t, app.cudoMintKeeper.GetMinter(ctx).EmissionCarry.IsZero())
+}
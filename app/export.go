@@ -18,6 +18,23 @@ import (
 func (app *App) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs []string,
 ) (servertypes.ExportedApp, error) {
+	return app.exportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, nil)
+}
+
+// ExportTestnetFork exports the state of the application for the genesis file
+// of a testnet forked from this chain, see TestnetFork.
+func (app *App) ExportTestnetFork(
+	forZeroHeight bool, jailAllowedAddrs []string, fork TestnetFork,
+) (servertypes.ExportedApp, error) {
+	if err := fork.Validate(); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	return app.exportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, &fork)
+}
+
+func (app *App) exportAppStateAndValidators(
+	forZeroHeight bool, jailAllowedAddrs []string, fork *TestnetFork,
+) (servertypes.ExportedApp, error) {
 
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
@@ -30,7 +47,19 @@ func (app *App) ExportAppStateAndValidators(
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
 
+	if fork != nil {
+		if err := app.forkValidators(ctx, fork.Validators); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	}
+
 	genState := app.mm.ExportGenesis(ctx, app.appCodec)
+	if fork != nil {
+		if err := app.forkGenesis(genState, *fork); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	}
+
 	appState, err := json.MarshalIndent(genState, "", "  ")
 	if err != nil {
 		return servertypes.ExportedApp{}, err
@@ -0,0 +1,152 @@
+package app
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// InPlaceTestnet describes the single validator network the state of an
+// existing node is turned into.
+type InPlaceTestnet struct {
+	ChainID   string
+	BlockTime time.Time
+
+	// ConsensusPubKey is the key of the validator operated by Operator,
+	// which is funded with the self delegation and the dev funds.
+	ConsensusPubKey cryptotypes.PubKey
+	Operator        sdk.AccAddress
+	SelfDelegation  sdk.Coin
+	DevFunds        sdk.Coins
+
+	// EthAddress is the gravity bridge key of the validator, which has to be
+	// in the bridge valset. Defaults to the operator address bytes.
+	EthAddress string
+
+	// VotingPeriod shortens the gov voting period when set, so that upgrade
+	// proposals pass quickly.
+	VotingPeriod time.Duration
+}
+
+// ConvertToInPlaceTestnet makes the new validator the only bonded one and
+// returns its consensus power. The changes are written to the working state
+// of the stores, which the first block of the testnet commits.
+func (app *App) ConvertToInPlaceTestnet(testnet InPlaceTestnet) (int64, error) {
+	ctx := app.NewContext(true, tmproto.Header{
+		ChainID: testnet.ChainID,
+		Height:  app.LastBlockHeight(),
+		Time:    testnet.BlockTime,
+	}).WithIsCheckTx(false)
+
+	cacheCtx, write := ctx.CacheContext()
+	power, err := app.convertToInPlaceTestnet(cacheCtx, testnet)
+	if err != nil {
+		return 0, err
+	}
+
+	write()
+	ctx.MultiStore().(storetypes.CacheMultiStore).Write()
+
+	return power, nil
+}
+
+func (app *App) convertToInPlaceTestnet(ctx sdk.Context, testnet InPlaceTestnet) (int64, error) {
+	valAddr := sdk.ValAddress(testnet.Operator)
+	consAddr := sdk.ConsAddress(testnet.ConsensusPubKey.Address())
+
+	if _, found := app.StakingKeeper.GetValidator(ctx, valAddr); found {
+		return 0, fmt.Errorf("%s already operates a validator", testnet.Operator)
+	}
+	if _, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr); found {
+		return 0, fmt.Errorf("the consensus key %s is already used by a validator", consAddr)
+	}
+	if bondDenom := app.StakingKeeper.BondDenom(ctx); testnet.SelfDelegation.Denom != bondDenom {
+		return 0, fmt.Errorf("the self delegation must be in %s", bondDenom)
+	}
+
+	funds := testnet.DevFunds.Add(testnet.SelfDelegation)
+	if err := app.BankKeeper.MintCoins(ctx, cudoMinttypes.ModuleName, funds); err != nil {
+		return 0, err
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, cudoMinttypes.ModuleName, testnet.Operator, funds); err != nil {
+		return 0, err
+	}
+
+	validator, err := stakingtypes.NewValidator(valAddr, testnet.ConsensusPubKey, stakingtypes.NewDescription("in-place-testnet", "", "", "", ""))
+	if err != nil {
+		return 0, err
+	}
+	validator.Commission = stakingtypes.NewCommissionWithTime(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2), ctx.BlockTime())
+	validator.MinSelfDelegation = sdk.OneInt()
+
+	app.StakingKeeper.SetValidator(ctx, validator)
+	if err := app.StakingKeeper.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return 0, err
+	}
+	app.StakingKeeper.SetNewValidatorByPowerIndex(ctx, validator)
+	app.ValidatorAllowlistKeeper.Allow(ctx, valAddr)
+
+	// the validator would be jailed at the end of the first block below the
+	// chain-wide self-delegation floor
+	if floorParams := app.SelfDelegationKeeper.GetParams(ctx); floorParams.IsBelowFloor(testnet.SelfDelegation.Amount) {
+		floorParams.MinSelfDelegation = testnet.SelfDelegation.Amount
+		app.SelfDelegationKeeper.SetParams(ctx, floorParams)
+	}
+	app.StakingKeeper.AfterValidatorCreated(ctx, valAddr)
+
+	if _, err := app.StakingKeeper.Delegate(ctx, testnet.Operator, testnet.SelfDelegation.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return 0, err
+	}
+
+	// gravity builds its valsets from the static validators with an eth key
+	ethAddress := testnet.EthAddress
+	if ethAddress == "" {
+		ethAddress = "0x" + hex.EncodeToString(testnet.Operator)
+	}
+	ethAddr, err := gravitytypes.NewEthAddress(ethAddress)
+	if err != nil {
+		return 0, err
+	}
+	app.GravityKeeper.SetStaticValCosmosAddr(ctx, testnet.Operator.String())
+	app.GravityKeeper.SetOrchestratorValidator(ctx, valAddr, testnet.Operator)
+	app.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, *ethAddr)
+
+	// the other validators leave the set when the changes are applied
+	for _, val := range app.StakingKeeper.GetLastValidators(ctx) {
+		if val.OperatorAddress == validator.OperatorAddress {
+			continue
+		}
+
+		valConsAddr, err := val.GetConsAddr()
+		if err != nil {
+			return 0, err
+		}
+		app.StakingKeeper.Jail(ctx, valConsAddr)
+	}
+
+	if _, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
+		return 0, err
+	}
+
+	power := app.StakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if power == 0 {
+		return 0, fmt.Errorf("a self delegation of %s has no consensus power", testnet.SelfDelegation)
+	}
+
+	if testnet.VotingPeriod > 0 {
+		votingParams := app.GovKeeper.GetVotingParams(ctx)
+		votingParams.VotingPeriod = testnet.VotingPeriod
+		app.GovKeeper.SetVotingParams(ctx, votingParams)
+	}
+
+	return power, nil
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	selfdelegationtypes "github.com/CudoVentures/cudos-node/x/selfdelegation/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestConvertToInPlaceTestnet(t *testing.T) {
+	genDoc, err := tmtypes.GenesisDocFromFile(upgradeGenesisFixture)
+	require.NoError(t, err)
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), emptyAppOptions{})
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         genDoc.ChainID,
+		AppStateBytes:   genDoc.AppState,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+	})
+	app.Commit()
+
+	// a floor above the self delegation of the testnet validator, written
+	// with the changes of the conversion
+	ctx := app.NewContext(true, tmproto.Header{})
+	app.SelfDelegationKeeper.SetParams(ctx, selfdelegationtypes.NewParams(sdk.DefaultPowerReduction.MulRaw(2000)))
+
+	testnet := InPlaceTestnet{
+		ChainID:         "testnet-1",
+		BlockTime:       genDoc.GenesisTime,
+		ConsensusPubKey: ed25519.GenPrivKey().PubKey(),
+		Operator:        sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		SelfDelegation:  sdk.NewCoin("acudos", sdk.DefaultPowerReduction.MulRaw(1000)),
+		DevFunds:        sdk.NewCoins(sdk.NewCoin("acudos", sdk.DefaultPowerReduction)),
+		VotingPeriod:    time.Minute,
+	}
+
+	power, err := app.ConvertToInPlaceTestnet(testnet)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), power)
+
+	ctx = app.NewContext(true, tmproto.Header{})
+	bonded := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.Len(t, bonded, 1)
+	require.Equal(t, sdk.ValAddress(testnet.Operator).String(), bonded[0].OperatorAddress)
+	require.Equal(t, testnet.DevFunds, app.BankKeeper.GetAllBalances(ctx, testnet.Operator))
+	require.Equal(t, time.Minute, app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	require.Equal(t, testnet.SelfDelegation.Amount, app.SelfDelegationKeeper.GetParams(ctx).MinSelfDelegation)
+
+	_, found := app.GravityKeeper.GetEthAddressByValidator(ctx, sdk.ValAddress(testnet.Operator))
+	require.True(t, found)
+
+	// the operator cannot create a second validator
+	_, err = app.ConvertToInPlaceTestnet(testnet)
+	require.Error(t, err)
+}
@@ -0,0 +1,353 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Wasm export modes of a testnet fork.
+const (
+	ForkWasmKeep   = "keep"
+	ForkWasmShrink = "shrink"
+	ForkWasmDrop   = "drop"
+)
+
+// TestnetFork describes how the exported state is changed to start a testnet
+// from it, e.g. to rehearse upgrades against realistic mainnet state.
+type TestnetFork struct {
+	// Validators take over the consensus of the bonded validators with the
+	// most power, all other bonded validators are jailed.
+	Validators []ForkValidator `json:"validators"`
+
+	Wasm ForkWasm `json:"wasm"`
+
+	// BalanceRemaps move the whole balance of an account to another one.
+	BalanceRemaps []BalanceRemap `json:"balance_remaps"`
+
+	// NormTimePassed resets the cudoMint minter progress on the emission curve,
+	// dropping the emission carried by the staking feedback.
+	NormTimePassed *sdk.Dec `json:"norm_time_passed,omitempty"`
+}
+
+// ForkValidator replaces the consensus key of a validator. Without an
+// operator address the next validator by power is taken.
+type ForkValidator struct {
+	OperatorAddress string          `json:"operator_address,omitempty"`
+	ConsensusPubKey json.RawMessage `json:"consensus_pubkey"`
+}
+
+// ForkWasm selects what is kept of the wasm state: everything, at most
+// MaxStateEntries entries of every contract's state, or no codes and
+// contracts at all.
+type ForkWasm struct {
+	Mode            string `json:"mode"`
+	MaxStateEntries int    `json:"max_state_entries,omitempty"`
+}
+
+// BalanceRemap moves the balance of From to To.
+type BalanceRemap struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// LoadTestnetFork reads a testnet fork description from a JSON file.
+func LoadTestnetFork(file string) (TestnetFork, error) {
+	var fork TestnetFork
+
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return fork, fmt.Errorf("failed to read testnet fork file: %w", err)
+	}
+	if err := json.Unmarshal(bz, &fork); err != nil {
+		return fork, fmt.Errorf("failed to parse testnet fork file: %w", err)
+	}
+
+	return fork, fork.Validate()
+}
+
+// Validate checks the testnet fork description.
+func (fork TestnetFork) Validate() error {
+	switch fork.Wasm.Mode {
+	case "", ForkWasmKeep, ForkWasmDrop:
+	case ForkWasmShrink:
+		if fork.Wasm.MaxStateEntries < 0 {
+			return fmt.Errorf("max state entries cannot be negative")
+		}
+	default:
+		return fmt.Errorf("unknown wasm mode %s, expected %s, %s or %s", fork.Wasm.Mode, ForkWasmKeep, ForkWasmShrink, ForkWasmDrop)
+	}
+
+	for _, remap := range fork.BalanceRemaps {
+		if err := remap.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if fork.NormTimePassed != nil && fork.NormTimePassed.IsNegative() {
+		return fmt.Errorf("norm time passed cannot be negative")
+	}
+
+	return nil
+}
+
+// Validate checks that the remap moves a balance between two different
+// accounts that are not module accounts, whose balances the modules track.
+func (remap BalanceRemap) Validate() error {
+	from, err := sdk.AccAddressFromBech32(remap.From)
+	if err != nil {
+		return fmt.Errorf("invalid balance remap sender %s: %w", remap.From, err)
+	}
+	to, err := sdk.AccAddressFromBech32(remap.To)
+	if err != nil {
+		return fmt.Errorf("invalid balance remap recipient %s: %w", remap.To, err)
+	}
+
+	if from.Equals(to) {
+		return fmt.Errorf("cannot remap the balance of %s to itself", remap.From)
+	}
+	for name := range maccPerms {
+		moduleAddr := authtypes.NewModuleAddress(name)
+		if from.Equals(moduleAddr) || to.Equals(moduleAddr) {
+			return fmt.Errorf("cannot remap the balance of module account %s", name)
+		}
+	}
+
+	return nil
+}
+
+// forkValidators hands the consensus of the strongest bonded validators over
+// to the fork keys and jails the remaining bonded validators.
+func (app *App) forkValidators(ctx sdk.Context, forkValidators []ForkValidator) error {
+	if len(forkValidators) == 0 {
+		return nil
+	}
+
+	bonded := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	taken := make(map[string]bool)
+	for _, forkVal := range forkValidators {
+		if forkVal.OperatorAddress != "" {
+			taken[forkVal.OperatorAddress] = true
+		}
+	}
+
+	next := 0
+	for _, forkVal := range forkValidators {
+		var pubKey cryptotypes.PubKey
+		if err := app.appCodec.UnmarshalInterfaceJSON(forkVal.ConsensusPubKey, &pubKey); err != nil {
+			return fmt.Errorf("invalid consensus pubkey %s: %w", forkVal.ConsensusPubKey, err)
+		}
+
+		operator := forkVal.OperatorAddress
+		if operator == "" {
+			for ; next < len(bonded) && taken[bonded[next].OperatorAddress]; next++ {
+			}
+			if next == len(bonded) {
+				return fmt.Errorf("the chain has only %d bonded validators for %d fork validators", len(bonded), len(forkValidators))
+			}
+			operator = bonded[next].OperatorAddress
+			taken[operator] = true
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(operator)
+		if err != nil {
+			return err
+		}
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return fmt.Errorf("validator %s not found", operator)
+		}
+		if !validator.IsBonded() {
+			return fmt.Errorf("validator %s is not bonded", operator)
+		}
+
+		if err := app.replaceConsensusPubKey(ctx, validator, pubKey); err != nil {
+			return err
+		}
+	}
+
+	for _, validator := range bonded {
+		if taken[validator.OperatorAddress] {
+			continue
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		app.StakingKeeper.Jail(ctx, consAddr)
+	}
+
+	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	return err
+}
+
+func (app *App) replaceConsensusPubKey(ctx sdk.Context, validator stakingtypes.Validator, pubKey cryptotypes.PubKey) error {
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	newConsAddr := sdk.ConsAddress(pubKey.Address())
+
+	if _, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, newConsAddr); found {
+		return fmt.Errorf("consensus pubkey of %s is already used by a validator", validator.OperatorAddress)
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return err
+	}
+	validator.ConsensusPubkey = pkAny
+
+	app.StakingKeeper.SetValidator(ctx, validator)
+	ctx.KVStore(app.keys[stakingtypes.StoreKey]).Delete(stakingtypes.GetValidatorByConsAddrKey(oldConsAddr))
+	if err := app.StakingKeeper.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	// the validator starts signing with a clean record
+	if err := app.SlashingKeeper.AddPubkey(ctx, pubKey); err != nil {
+		return err
+	}
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, newConsAddr, slashingtypes.NewValidatorSigningInfo(newConsAddr, ctx.BlockHeight(), 0, ctx.BlockTime(), false, 0))
+
+	return nil
+}
+
+// forkGenesis applies the parts of the fork that are easier done on the
+// exported genesis than on the stores.
+func (app *App) forkGenesis(genState map[string]json.RawMessage, fork TestnetFork) error {
+	if err := forkWasmGenesis(app.appCodec, genState, fork.Wasm); err != nil {
+		return err
+	}
+	if err := remapBalances(app.appCodec, genState, fork.BalanceRemaps); err != nil {
+		return err
+	}
+
+	if fork.NormTimePassed != nil {
+		var cudoMintGenState cudoMinttypes.GenesisState
+		if err := app.appCodec.UnmarshalJSON(genState[cudoMinttypes.ModuleName], &cudoMintGenState); err != nil {
+			return err
+		}
+
+		cudoMintGenState.Minter.NormTimePassed = *fork.NormTimePassed
+		cudoMintGenState.Minter.MintRemainder = sdk.ZeroDec()
+		cudoMintGenState.Minter.EmissionCarry = sdk.ZeroInt()
+
+		bz, err := app.appCodec.MarshalJSON(&cudoMintGenState)
+		if err != nil {
+			return err
+		}
+		genState[cudoMinttypes.ModuleName] = bz
+	}
+
+	return nil
+}
+
+func forkWasmGenesis(cdc codec.JSONCodec, genState map[string]json.RawMessage, forkWasm ForkWasm) error {
+	if forkWasm.Mode == "" || forkWasm.Mode == ForkWasmKeep {
+		return nil
+	}
+
+	var wasmGenState wasmtypes.GenesisState
+	if err := cdc.UnmarshalJSON(genState[wasmtypes.ModuleName], &wasmGenState); err != nil {
+		return err
+	}
+
+	switch forkWasm.Mode {
+	case ForkWasmDrop:
+		// the sequences are kept so that no new contract takes an old address
+		wasmGenState.Codes = nil
+		wasmGenState.Contracts = nil
+	case ForkWasmShrink:
+		for i, contract := range wasmGenState.Contracts {
+			if len(contract.ContractState) > forkWasm.MaxStateEntries {
+				wasmGenState.Contracts[i].ContractState = contract.ContractState[:forkWasm.MaxStateEntries]
+			}
+		}
+	}
+
+	bz, err := cdc.MarshalJSON(&wasmGenState)
+	if err != nil {
+		return err
+	}
+	genState[wasmtypes.ModuleName] = bz
+
+	return nil
+}
+
+// remapBalances moves the balances in the bank genesis, creating the
+// receiving accounts. The coins locked by a vesting sender become spendable.
+func remapBalances(cdc codec.Codec, genState map[string]json.RawMessage, remaps []BalanceRemap) error {
+	if len(remaps) == 0 {
+		return nil
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, genState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return err
+	}
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, genState)
+
+	// new accounts are numbered after the existing ones, which keep theirs
+	nextAccountNumber := uint64(0)
+	for _, acc := range accs {
+		if acc.GetAccountNumber() >= nextAccountNumber {
+			nextAccountNumber = acc.GetAccountNumber() + 1
+		}
+	}
+
+	balances := make(map[string]sdk.Coins)
+	for _, balance := range bankGenState.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+
+	for _, remap := range remaps {
+		from, err := sdk.AccAddressFromBech32(remap.From)
+		if err != nil {
+			return err
+		}
+		to, err := sdk.AccAddressFromBech32(remap.To)
+		if err != nil {
+			return err
+		}
+
+		if !accs.Contains(to) {
+			accs = append(accs, authtypes.NewBaseAccount(to, nil, nextAccountNumber, 0))
+			nextAccountNumber++
+		}
+
+		balances[to.String()] = balances[to.String()].Add(balances[from.String()]...)
+		delete(balances, from.String())
+	}
+
+	bankGenState.Balances = make([]banktypes.Balance, 0, len(balances))
+	for address, coins := range balances {
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: address, Coins: coins})
+	}
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+	if authGenState.Accounts, err = authtypes.PackAccounts(authtypes.SanitizeGenesisAccounts(accs)); err != nil {
+		return err
+	}
+	if genState[authtypes.ModuleName], err = cdc.MarshalJSON(&authGenState); err != nil {
+		return err
+	}
+	if genState[banktypes.ModuleName], err = cdc.MarshalJSON(bankGenState); err != nil {
+		return err
+	}
+
+	return nil
+}
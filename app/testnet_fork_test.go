@@ -0,0 +1,110 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestExportTestnetFork(t *testing.T) {
+	genDoc, err := tmtypes.GenesisDocFromFile(upgradeGenesisFixture)
+	require.NoError(t, err)
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), emptyAppOptions{})
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         genDoc.ChainID,
+		AppStateBytes:   genDoc.AppState,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+	})
+	app.Commit()
+
+	// emission held back by the staking feedback
+	ctx := app.NewContext(true, tmproto.Header{})
+	minter := app.cudoMintKeeper.GetMinter(ctx)
+	minter.EmissionCarry = sdk.NewInt(1000)
+	app.cudoMintKeeper.SetMinter(ctx, minter)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	pubKeyJSON, err := app.appCodec.MarshalInterfaceJSON(pubKey)
+	require.NoError(t, err)
+
+	from := "cudos1f2j974xawuajn3w7q4pwqx6vk7p4tdpnhsrrka"
+	to := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	normTimePassed := sdk.MustNewDecFromStr("0.25")
+
+	exported, err := app.ExportTestnetFork(false, nil, TestnetFork{
+		Validators:     []ForkValidator{{ConsensusPubKey: pubKeyJSON}},
+		Wasm:           ForkWasm{Mode: ForkWasmDrop},
+		BalanceRemaps:  []BalanceRemap{{From: from, To: to.String()}},
+		NormTimePassed: &normTimePassed,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, exported.Validators, 1)
+	require.Equal(t, pubKey.Address().Bytes(), exported.Validators[0].Address.Bytes())
+
+	var genState GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genState))
+	require.NoError(t, ModuleBasics.ValidateGenesis(app.appCodec, MakeEncodingConfig().TxConfig, genState))
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(app.appCodec, genState)
+	var toBalance sdk.Coins
+	for _, balance := range bankGenState.Balances {
+		require.NotEqual(t, from, balance.Address)
+		if balance.Address == to.String() {
+			toBalance = balance.Coins
+		}
+	}
+	require.False(t, toBalance.IsZero())
+
+	var cudoMintGenState cudoMinttypes.GenesisState
+	app.appCodec.MustUnmarshalJSON(genState[cudoMinttypes.ModuleName], &cudoMintGenState)
+	require.Equal(t, normTimePassed, cudoMintGenState.Minter.NormTimePassed)
+	require.True(t, cudoMintGenState.Minter.EmissionCarry.IsZero())
+}
+
+func TestTestnetForkBalanceRemaps(t *testing.T) {
+	account := sdk.AccAddress([]byte("account_____________")).String()
+	other := sdk.AccAddress([]byte("other_______________")).String()
+	module := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+
+	for _, tc := range []struct {
+		name  string
+		remap BalanceRemap
+		err   string
+	}{
+		{name: "valid", remap: BalanceRemap{From: account, To: other}},
+		{name: "invalid sender", remap: BalanceRemap{From: "cudos1invalid", To: other}, err: "invalid balance remap sender"},
+		{name: "invalid recipient", remap: BalanceRemap{From: account, To: ""}, err: "invalid balance remap recipient"},
+		{name: "self remap", remap: BalanceRemap{From: account, To: account}, err: "to itself"},
+		{name: "from module account", remap: BalanceRemap{From: module, To: other}, err: "module account distribution"},
+		{name: "to module account", remap: BalanceRemap{From: account, To: module}, err: "module account distribution"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := TestnetFork{BalanceRemaps: []BalanceRemap{tc.remap}}.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+
+	// a self remap used to drop the balance
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), emptyAppOptions{})
+	_, err := app.ExportTestnetFork(false, nil, TestnetFork{BalanceRemaps: []BalanceRemap{{From: account, To: account}}})
+	require.ErrorContains(t, err, "to itself")
+}
@@ -0,0 +1,206 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// UpgradeSimulation is the outcome of running an upgrade against the local
+// state without committing it.
+type UpgradeSimulation struct {
+	Plan          string                   `json:"plan"`
+	Height        int64                    `json:"height"`
+	StoreUpgrades storetypes.StoreUpgrades `json:"store_upgrades"`
+	Modules       []ModuleMigration        `json:"modules"`
+	Invariants    []InvariantResult        `json:"invariants"`
+	StoreDiffs    []StoreDiff              `json:"store_diffs"`
+}
+
+// ModuleMigration reports the consensus version of a module before and after the upgrade.
+type ModuleMigration struct {
+	Module      string `json:"module"`
+	FromVersion uint64 `json:"from_version"`
+	ToVersion   uint64 `json:"to_version"`
+}
+
+// InvariantResult reports a registered invariant checked after the upgrade.
+type InvariantResult struct {
+	Route   string `json:"route"`
+	Broken  bool   `json:"broken"`
+	Message string `json:"message,omitempty"`
+}
+
+// StoreDiff counts the keys the upgrade added, updated and deleted in a store.
+type StoreDiff struct {
+	Store   string `json:"store"`
+	Added   int    `json:"added"`
+	Updated int    `json:"updated"`
+	Deleted int    `json:"deleted"`
+}
+
+// storeDiffListener sorts the writes of the upgrade into store diffs by
+// looking the keys up in the state before the upgrade. Stores without state
+// before the upgrade have no entry in before.
+type storeDiffListener struct {
+	before map[string]storetypes.KVStore
+	diffs  map[string]*StoreDiff
+}
+
+func (l *storeDiffListener) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	var previous []byte
+	if before, ok := l.before[storeKey.Name()]; ok {
+		previous = before.Get(key)
+	}
+
+	// deleting a missing key or rewriting a value changes nothing
+	if (delete && previous == nil) || (!delete && previous != nil && bytes.Equal(previous, value)) {
+		return nil
+	}
+
+	diff, ok := l.diffs[storeKey.Name()]
+	if !ok {
+		diff = &StoreDiff{Store: storeKey.Name()}
+		l.diffs[storeKey.Name()] = diff
+	}
+
+	switch {
+	case delete:
+		diff.Deleted++
+	case previous != nil:
+		diff.Updated++
+	default:
+		diff.Added++
+	}
+
+	return nil
+}
+
+// SimulateUpgrade loads the latest state with the store changes of the named
+// upgrade, applies the upgrade in a cache-wrapped context and reports what it
+// did. The app must have been created without loading the latest version and
+// nothing is committed.
+func (app *App) SimulateUpgrade(name string) (UpgradeSimulation, error) {
+	var upgrade *Upgrade
+	for i := range Upgrades {
+		if Upgrades[i].Name == name {
+			upgrade = &Upgrades[i]
+		}
+	}
+	if upgrade == nil {
+		return UpgradeSimulation{}, fmt.Errorf("unknown upgrade %s", name)
+	}
+
+	// the multistore is only accessible until the app is sealed by loading it
+	cms := app.CommitMultiStore()
+
+	storeUpgrades := upgrade.StoreUpgrades
+	app.SetStoreLoader(func(ms sdk.CommitMultiStore) error {
+		return ms.LoadLatestVersionAndUpgrade(&storeUpgrades)
+	})
+	if err := app.LoadLatestVersion(); err != nil {
+		return UpgradeSimulation{}, fmt.Errorf("applying the store upgrades of %s: %w", name, err)
+	}
+
+	// stores added by the upgrade have no state at the last height
+	listener := &storeDiffListener{before: make(map[string]storetypes.KVStore), diffs: make(map[string]*StoreDiff)}
+	for name, key := range app.keys {
+		if !storeUpgrades.IsAdded(name) {
+			store, ok := cms.GetCommitKVStore(key).(*iavl.Store)
+			if !ok {
+				return UpgradeSimulation{}, fmt.Errorf("store %s is not an IAVL store", name)
+			}
+			before, err := store.GetImmutable(app.LastBlockHeight())
+			if err != nil {
+				return UpgradeSimulation{}, fmt.Errorf("loading store %s at height %d: %w", name, app.LastBlockHeight(), err)
+			}
+			listener.before[name] = before
+		}
+		cms.AddListeners(key, []storetypes.WriteListener{listener})
+	}
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now().UTC()}
+	cacheMS := cms.CacheMultiStore()
+	ctx := sdk.NewContext(cacheMS, header, false, app.Logger())
+
+	if height := app.UpgradeKeeper.GetDoneHeight(ctx, name); height != 0 {
+		return UpgradeSimulation{}, fmt.Errorf("upgrade %s was already applied at height %d", name, height)
+	}
+
+	fromVM := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	if err := applyUpgrade(ctx, app, upgradetypes.Plan{Name: name, Height: header.Height}); err != nil {
+		return UpgradeSimulation{}, err
+	}
+	toVM := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+
+	simulation := UpgradeSimulation{
+		Plan:          name,
+		Height:        header.Height,
+		StoreUpgrades: storeUpgrades,
+		Modules:       []ModuleMigration{},
+		Invariants:    []InvariantResult{},
+		StoreDiffs:    []StoreDiff{},
+	}
+
+	for moduleName, version := range toVM {
+		simulation.Modules = append(simulation.Modules, ModuleMigration{Module: moduleName, FromVersion: fromVM[moduleName], ToVersion: version})
+	}
+	sort.Slice(simulation.Modules, func(i, j int) bool { return simulation.Modules[i].Module < simulation.Modules[j].Module })
+
+	for _, route := range app.CrisisKeeper.Routes() {
+		simulation.Invariants = append(simulation.Invariants, checkInvariant(ctx, route.FullRoute(), route.Invar))
+	}
+
+	// writes into the working trees of the stores, which are never saved
+	cacheMS.Write()
+
+	for _, diff := range listener.diffs {
+		simulation.StoreDiffs = append(simulation.StoreDiffs, *diff)
+	}
+	sort.Slice(simulation.StoreDiffs, func(i, j int) bool { return simulation.StoreDiffs[i].Store < simulation.StoreDiffs[j].Store })
+
+	return simulation, nil
+}
+
+func applyUpgrade(ctx sdk.Context, app *App, plan upgradetypes.Plan) (err error) {
+	if !app.UpgradeKeeper.HasHandler(plan.Name) {
+		return fmt.Errorf("no upgrade handler registered for %s", plan.Name)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade %s failed: %v", plan.Name, r)
+		}
+	}()
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+	return nil
+}
+
+func checkInvariant(ctx sdk.Context, route string, invariant sdk.Invariant) (result InvariantResult) {
+	result.Route = route
+
+	defer func() {
+		if r := recover(); r != nil {
+			result.Broken = true
+			result.Message = fmt.Sprintf("%v", r)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	msg, broken := invariant(cacheCtx)
+	result.Broken = broken
+	if broken {
+		result.Message = msg
+	}
+
+	return result
+}
@@ -0,0 +1,156 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	admintypes "github.com/CudoVentures/cudos-node/x/admin/types"
+	validatorallowlisttypes "github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func msgCreateValidator(t *testing.T, operator sdk.AccAddress, selfDelegation sdk.Coin) *stakingtypes.MsgCreateValidator {
+	minSelfDelegation, _ := sdk.NewIntFromString(stakingtypes.MinSelfDelegation)
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(operator), ed25519.GenPrivKey().PubKey(), selfDelegation,
+		stakingtypes.NewDescription(operator.String(), "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), minSelfDelegation,
+	)
+	require.NoError(t, err)
+	return msg
+}
+
+// TestValidatorAllowlistHook starts a chain whose genesis validator is
+// created by a gentx of an operator that is not on the allowlist, then has a
+// group execute a proposal creating a validator after its operator was
+// disallowed, which the ante handler cannot see.
+func TestValidatorAllowlistHook(t *testing.T) {
+	encCfg := MakeEncodingConfig()
+	cdc := encCfg.Codec
+	chainID := "allowlist-1"
+
+	operatorKey := secp256k1.GenPrivKey()
+	operator := sdk.AccAddress(operatorKey.PubKey().Address())
+	var operatorSeq uint64
+	signTx := func(msgs ...sdk.Msg) []byte {
+		tx, err := helpers.GenTx(encCfg.TxConfig, msgs, sdk.NewCoins(), helpers.DefaultGenTxGas*10, chainID, []uint64{0}, []uint64{operatorSeq}, operatorKey)
+		require.NoError(t, err)
+		operatorSeq++
+		bz, err := encCfg.TxConfig.TxJSONEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+
+	genState := NewDefaultGenesisState(cdc)
+	var stakingGenState stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(genState[stakingtypes.ModuleName], &stakingGenState)
+	minSelfDelegation, _ := sdk.NewIntFromString(stakingtypes.MinSelfDelegation)
+	selfDelegation := sdk.NewCoin(stakingGenState.Params.BondDenom, minSelfDelegation)
+
+	accounts, err := authtypes.PackAccounts(authtypes.GenesisAccounts{authtypes.NewBaseAccount(operator, nil, 0, 0)})
+	require.NoError(t, err)
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, genState)
+	authGenState.Accounts = accounts
+	genState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenState)
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, genState)
+	bankGenState.Balances = []banktypes.Balance{{
+		Address: operator.String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(selfDelegation.Denom, minSelfDelegation.MulRaw(3)), sdk.NewInt64Coin(admintypes.AdminDenom, 1)),
+	}}
+	genState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenState)
+
+	var gravityGenState gravitytypes.GenesisState
+	cdc.MustUnmarshalJSON(genState[gravitytypes.ModuleName], &gravityGenState)
+	gravityGenState.StaticValCosmosAddrs = []string{operator.String()}
+	gravityGenState.DelegateKeys = []*gravitytypes.MsgSetOrchestratorAddress{{
+		Validator:    sdk.ValAddress(operator).String(),
+		Orchestrator: operator.String(),
+		EthAddress:   "0x0000000000000000000000000000000000000001",
+	}}
+	genState[gravitytypes.ModuleName] = cdc.MustMarshalJSON(&gravityGenState)
+
+	// the allowlist is enabled and empty
+	genState[genutiltypes.ModuleName] = cdc.MustMarshalJSON(genutiltypes.NewGenesisState([]json.RawMessage{signTx(msgCreateValidator(t, operator, selfDelegation))}))
+	appStateBytes, err := json.Marshal(genState)
+	require.NoError(t, err)
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, encCfg, emptyAppOptions{})
+	require.NotPanics(t, func() {
+		app.InitChain(abci.RequestInitChain{ChainId: chainID, AppStateBytes: appStateBytes, ConsensusParams: simapp.DefaultConsensusParams})
+	})
+	app.Commit()
+
+	ctx := app.NewContext(true, tmproto.Header{})
+	_, found := app.StakingKeeper.GetValidator(ctx, sdk.ValAddress(operator))
+	require.True(t, found)
+	require.True(t, app.ValidatorAllowlistKeeper.IsAllowed(ctx, sdk.ValAddress(operator)))
+
+	header := tmproto.Header{ChainID: chainID, Height: app.LastBlockHeight() + 1, Time: time.Now()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	deliver := func(msgs ...sdk.Msg) abci.ResponseDeliverTx {
+		tx, err := encCfg.TxConfig.TxJSONDecoder()(signTx(msgs...))
+		require.NoError(t, err)
+		bz, err := encCfg.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return app.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+	}
+
+	createGroup, err := group.NewMsgCreateGroupWithPolicy(operator.String(), []group.MemberRequest{{Address: operator.String(), Weight: "1"}}, "", "", false, group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	require.NoError(t, err)
+	res := deliver(createGroup)
+	require.Zero(t, res.Code, res.Log)
+	var txMsgData sdk.TxMsgData
+	require.NoError(t, cdc.Unmarshal(res.Data, &txMsgData))
+	var createGroupRes group.MsgCreateGroupWithPolicyResponse
+	require.NoError(t, cdc.Unmarshal(txMsgData.Data[0].Data, &createGroupRes))
+	policy, err := sdk.AccAddressFromBech32(createGroupRes.GroupPolicyAddress)
+	require.NoError(t, err)
+
+	// the proposal passes the ante handler while the policy may create a validator
+	res = deliver(
+		banktypes.NewMsgSend(operator, policy, sdk.NewCoins(selfDelegation)),
+		validatorallowlisttypes.NewMsgUpdateOperatorAllowlist(operator, []string{sdk.ValAddress(policy).String()}, nil),
+	)
+	require.Zero(t, res.Code, res.Log)
+	submitProposal, err := group.NewMsgSubmitProposal(policy.String(), []string{operator.String()}, []sdk.Msg{msgCreateValidator(t, policy, selfDelegation)}, "", group.Exec_EXEC_UNSPECIFIED)
+	require.NoError(t, err)
+	res = deliver(submitProposal, &group.MsgVote{ProposalId: 1, Voter: operator.String(), Option: group.VOTE_OPTION_YES})
+	require.Zero(t, res.Code, res.Log)
+
+	// the hook rejects the validator when the proposal is executed after the
+	// policy was disallowed, which fails the whole tx
+	res = deliver(validatorallowlisttypes.NewMsgUpdateOperatorAllowlist(operator, nil, []string{sdk.ValAddress(policy).String()}))
+	require.Zero(t, res.Code, res.Log)
+	res = deliver(&group.MsgExec{ProposalId: 1, Executor: operator.String()})
+	require.Equal(t, sdkerrors.ErrPanic.ABCICode(), res.Code, res.Log)
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	ctx = app.NewContext(true, tmproto.Header{})
+	_, found = app.StakingKeeper.GetValidator(ctx, sdk.ValAddress(policy))
+	require.False(t, found)
+	require.Equal(t, selfDelegation, app.BankKeeper.GetBalance(ctx, policy, selfDelegation.Denom))
+	proposal, err := app.GroupKeeper.Proposal(sdk.WrapSDKContext(ctx), &group.QueryProposalRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.Proposal.ExecutorResult)
+}
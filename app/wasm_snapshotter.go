@@ -0,0 +1,174 @@
+package app
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmutils "github.com/CosmWasm/wasmd/x/wasm/client/utils"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	snapshot "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	// WasmSnapshotName is the name of the wasm extension in state-sync snapshots
+	WasmSnapshotName = "wasm"
+
+	// WasmSnapshotFormat stores every wasm code as one gzipped payload
+	WasmSnapshotFormat uint32 = 1
+)
+
+var _ snapshot.ExtensionSnapshotter = &WasmSnapshotter{}
+
+// WasmSnapshotter adds the wasm code kept by wasmvm outside of the IAVL stores
+// to state-sync snapshots, so that restored nodes can run the contracts.
+type WasmSnapshotter struct {
+	cms        sdk.MultiStore
+	wasmKeeper *wasm.Keeper
+	storeKey   sdk.StoreKey
+}
+
+func NewWasmSnapshotter(cms sdk.MultiStore, wasmKeeper *wasm.Keeper, storeKey sdk.StoreKey) *WasmSnapshotter {
+	return &WasmSnapshotter{
+		cms:        cms,
+		wasmKeeper: wasmKeeper,
+		storeKey:   storeKey,
+	}
+}
+
+func (ws *WasmSnapshotter) SnapshotName() string {
+	return WasmSnapshotName
+}
+
+func (ws *WasmSnapshotter) SnapshotFormat() uint32 {
+	return WasmSnapshotFormat
+}
+
+func (ws *WasmSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{WasmSnapshotFormat}
+}
+
+// Snapshot writes the code of every code info stored at the given height.
+func (ws *WasmSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	cacheMS, err := ws.cms.CacheMultiStoreWithVersion(int64(height))
+	if err != nil {
+		return err
+	}
+	ctx := sdk.NewContext(cacheMS, tmproto.Header{}, false, log.NewNopLogger())
+
+	seen := make(map[string]bool)
+	var rerr error
+	ws.wasmKeeper.IterateCodeInfos(ctx, func(codeID uint64, info wasmtypes.CodeInfo) bool {
+		hash := hex.EncodeToString(info.CodeHash)
+		if seen[hash] {
+			return false
+		}
+		seen[hash] = true
+
+		code, err := ws.wasmKeeper.GetByteCode(ctx, codeID)
+		if err != nil {
+			rerr = sdkerrors.Wrapf(err, "code %d", codeID)
+			return true
+		}
+		compressed, err := wasmutils.GzipIt(code)
+		if err != nil {
+			rerr = err
+			return true
+		}
+		rerr = snapshot.WriteExtensionItem(protoWriter, compressed)
+		return rerr != nil
+	})
+
+	return rerr
+}
+
+// Restore stores the code of the snapshot in the wasm directory and pins the
+// codes marked as pinned in the already restored wasm store. Every code must
+// match the hash of a code info of the restored store.
+func (ws *WasmSnapshotter) Restore(height uint64, format uint32, protoReader protoio.Reader) (snapshot.SnapshotItem, error) {
+	if format != WasmSnapshotFormat {
+		return snapshot.SnapshotItem{}, sdkerrors.Wrapf(snapshot.ErrUnknownFormat, "format %v", format)
+	}
+
+	ctx := sdk.NewContext(ws.cms.CacheMultiStore(), tmproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+	codes := make(map[string]wasmtypes.Code)
+	ws.wasmKeeper.IterateCodeInfos(ctx, func(codeID uint64, info wasmtypes.CodeInfo) bool {
+		codes[hex.EncodeToString(info.CodeHash)] = wasmtypes.Code{CodeID: codeID, CodeInfo: info}
+		return false
+	})
+	params := ws.wasmKeeper.GetParams(ctx)
+
+	var next snapshot.SnapshotItem
+	for {
+		next = snapshot.SnapshotItem{}
+		err := protoReader.ReadMsg(&next)
+		if err == io.EOF {
+			next = snapshot.SnapshotItem{}
+			break
+		} else if err != nil {
+			return snapshot.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		payload := next.GetExtensionPayload()
+		if payload == nil {
+			break
+		}
+
+		code, err := uncompressWasm(payload.Payload)
+		if err != nil {
+			return snapshot.SnapshotItem{}, err
+		}
+		hash := sha256.Sum256(code)
+		imported, found := codes[hex.EncodeToString(hash[:])]
+		if !found {
+			return snapshot.SnapshotItem{}, sdkerrors.Wrapf(wasmtypes.ErrNotFound, "code info for hash %X", hash)
+		}
+		imported.CodeBytes = code
+
+		// the genesis import stores the code with the keeper's own VM and
+		// checks its hash; the code info it writes again is discarded
+		importCtx, _ := ctx.CacheContext()
+		importCtx.KVStore(ws.storeKey).Delete(wasmtypes.GetCodeKey(imported.CodeID))
+		genState := wasmtypes.GenesisState{Params: params, Codes: []wasmtypes.Code{imported}}
+		if _, err := wasmkeeper.InitGenesis(importCtx, ws.wasmKeeper, genState, nil, nil); err != nil {
+			return snapshot.SnapshotItem{}, err
+		}
+	}
+
+	if err := ws.wasmKeeper.InitializePinnedCodes(ctx); err != nil {
+		return snapshot.SnapshotItem{}, err
+	}
+
+	return next, nil
+}
+
+func uncompressWasm(src []byte) ([]byte, error) {
+	if !wasmutils.IsGzip(src) {
+		return nil, sdkerrors.Wrap(wasmtypes.ErrInvalid, "wasm snapshot payload is not gzipped")
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	code, err := ioutil.ReadAll(io.LimitReader(zr, wasmtypes.MaxWasmSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(code) > wasmtypes.MaxWasmSize {
+		return nil, wasmtypes.ErrLimit
+	}
+	return code, nil
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	snapshot "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	protoio "github.com/gogo/protobuf/io"
+)
+
+// testWasmCode is the smallest module wasmvm accepts as a contract: one
+// memory and the allocate, deallocate, instantiate and interface_version_8
+// exports.
+var testWasmCode = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+	// types: (i32) -> i32, (i32) -> (), () -> (), (i32, i32, i32) -> i32
+	0x01, 0x14, 0x04,
+	0x60, 0x01, 0x7f, 0x01, 0x7f,
+	0x60, 0x01, 0x7f, 0x00,
+	0x60, 0x00, 0x00,
+	0x60, 0x03, 0x7f, 0x7f, 0x7f, 0x01, 0x7f,
+	// functions
+	0x03, 0x05, 0x04, 0x00, 0x01, 0x02, 0x03,
+	// memory of one page
+	0x05, 0x03, 0x01, 0x00, 0x01,
+	// exports
+	0x07, 0x46, 0x05,
+	0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00,
+	0x08, 'a', 'l', 'l', 'o', 'c', 'a', 't', 'e', 0x00, 0x00,
+	0x0a, 'd', 'e', 'a', 'l', 'l', 'o', 'c', 'a', 't', 'e', 0x00, 0x01,
+	0x13, 'i', 'n', 't', 'e', 'r', 'f', 'a', 'c', 'e', '_', 'v', 'e', 'r', 's', 'i', 'o', 'n', '_', '8', 0x00, 0x02,
+	0x0b, 'i', 'n', 's', 't', 'a', 'n', 't', 'i', 'a', 't', 'e', 0x00, 0x03,
+	// code
+	0x0a, 0x11, 0x04,
+	0x04, 0x00, 0x41, 0x00, 0x0b,
+	0x02, 0x00, 0x0b,
+	0x02, 0x00, 0x0b,
+	0x04, 0x00, 0x41, 0x00, 0x0b,
+}
+
+func TestWasmSnapshotter(t *testing.T) {
+	genDoc, err := tmtypes.GenesisDocFromFile(upgradeGenesisFixture)
+	require.NoError(t, err)
+
+	// the commit multistore is only reachable before the app is sealed
+	newApp := func() (*App, sdk.CommitMultiStore) {
+		var cms sdk.CommitMultiStore
+		app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), emptyAppOptions{}, func(bapp *baseapp.BaseApp) {
+			cms = bapp.CommitMultiStore()
+		})
+		app.InitChain(abci.RequestInitChain{
+			ChainId:         genDoc.ChainID,
+			AppStateBytes:   genDoc.AppState,
+			ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+		})
+		app.Commit()
+		return app, cms
+	}
+
+	// the same code uploaded twice, the second one pinned
+	source, sourceCMS := newApp()
+	ctx := sdk.NewContext(sourceCMS, tmproto.Header{}, false, log.NewNopLogger())
+	contractKeeper := wasmkeeper.NewGovPermissionKeeper(&source.wasmKeeper)
+	creator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	codeID, err := contractKeeper.Create(ctx, creator, testWasmCode, nil)
+	require.NoError(t, err)
+	pinnedCodeID, err := contractKeeper.Create(ctx, creator, testWasmCode, nil)
+	require.NoError(t, err)
+	require.NoError(t, contractKeeper.PinCode(ctx, pinnedCodeID))
+	sourceCMS.Commit()
+	height := uint64(sourceCMS.LastCommitID().Version)
+
+	var buf bytes.Buffer
+	sourceSnapshotter := NewWasmSnapshotter(sourceCMS, &source.wasmKeeper, source.keys[wasm.StoreKey])
+	require.NoError(t, sourceSnapshotter.Snapshot(height, protoio.NewDelimitedWriter(&buf)))
+	snapshotBytes := buf.Bytes()
+
+	// state sync restores the wasm store before the extension, but not the
+	// code kept in the wasm directory
+	target, targetCMS := newApp()
+	sourceStore := sourceCMS.GetKVStore(source.keys[wasm.StoreKey])
+	targetStore := targetCMS.GetKVStore(target.keys[wasm.StoreKey])
+	iter := sourceStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		targetStore.Set(iter.Key(), iter.Value())
+	}
+	require.NoError(t, iter.Close())
+	targetCMS.Commit()
+
+	ctx = sdk.NewContext(targetCMS, tmproto.Header{}, false, log.NewNopLogger())
+	_, err = target.wasmKeeper.GetByteCode(ctx, codeID)
+	require.Error(t, err)
+
+	targetSnapshotter := NewWasmSnapshotter(targetCMS, &target.wasmKeeper, target.keys[wasm.StoreKey])
+	next, err := targetSnapshotter.Restore(height, WasmSnapshotFormat, protoio.NewDelimitedReader(bytes.NewReader(snapshotBytes), len(snapshotBytes)))
+	require.NoError(t, err)
+	require.Equal(t, snapshot.SnapshotItem{}, next)
+
+	for _, id := range []uint64{codeID, pinnedCodeID} {
+		code, err := target.wasmKeeper.GetByteCode(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, testWasmCode, code)
+	}
+	require.True(t, target.wasmKeeper.IsPinnedCode(ctx, pinnedCodeID))
+
+	// payloads must be gzipped
+	buf.Reset()
+	require.NoError(t, snapshot.WriteExtensionItem(protoio.NewDelimitedWriter(&buf), testWasmCode))
+	_, err = targetSnapshotter.Restore(height, WasmSnapshotFormat, protoio.NewDelimitedReader(&buf, buf.Len()))
+	require.ErrorIs(t, err, wasmtypes.ErrInvalid)
+
+	_, err = targetSnapshotter.Restore(height, WasmSnapshotFormat+1, protoio.NewDelimitedReader(bytes.NewReader(snapshotBytes), len(snapshotBytes)))
+	require.ErrorIs(t, err, snapshot.ErrUnknownFormat)
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/CudoVentures/cudos-node/x/cudoMint"
+	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagBlocksPerDay  = "blocks-per-day"
+	flagFromHeight    = "from-height"
+	flagToHeight      = "to-height"
+	flagStep          = "step"
+	flagInitialSupply = "initial-supply"
+)
+
+// CudoMintCmd returns the offline cudoMint commands.
+func CudoMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "cudomint",
+		Short:                      "Offline cudoMint utilities",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+		// unlike the root command, the utilities neither read nor write the
+		// config of a node home
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+	}
+
+	cmd.AddCommand(CudoMintScheduleCmd())
+
+	return cmd
+}
+
+// CudoMintScheduleCmd returns a command computing the cudoMint emission of
+// future blocks without a node.
+func CudoMintScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Compute the cudoMint emission schedule",
+		Long: fmt.Sprintf(`Compute the emission of every --step blocks between --from-height and
+--to-height with the math of the cudoMint module, as a CSV or JSON table of the
+height, the normalized time passed after the block, the %[1]s minted by the
+block and the supply after it.
+
+--blocks-per-day is the increment modifier param of the chain. The supply is
+the amount minted since the first block plus --initial-supply. By default the
+table has a row a day until the block after the emission ends.
+
+The schedule leaves out the staking feedback, the chain mints differently
+while the EmissionFactorMin and EmissionFactorMax params are not both 1.
+
+Example:
+$ cudos-noded cudomint schedule --blocks-per-day 17280 --from-height 1000000 --to-height 2000000 --step 100000 --output json
+`, cudoMinttypes.MintDenom),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			blocksPerDay, _ := cmd.Flags().GetInt64(flagBlocksPerDay)
+			fromHeight, _ := cmd.Flags().GetInt64(flagFromHeight)
+			toHeight, _ := cmd.Flags().GetInt64(flagToHeight)
+			step, _ := cmd.Flags().GetInt64(flagStep)
+			output, _ := cmd.Flags().GetString(tmcli.OutputFlag)
+
+			normTimePassedStr, _ := cmd.Flags().GetString(flagNormTimePassed)
+			normTimePassed, err := sdk.NewDecFromStr(normTimePassedStr)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %w", flagNormTimePassed, err)
+			}
+
+			initialSupplyStr, _ := cmd.Flags().GetString(flagInitialSupply)
+			initialSupply, ok := sdk.NewIntFromString(initialSupplyStr)
+			if !ok || initialSupply.IsNegative() {
+				return fmt.Errorf("invalid %s: %s", flagInitialSupply, initialSupplyStr)
+			}
+
+			if blocksPerDay < 1 {
+				return fmt.Errorf("%s must be positive", flagBlocksPerDay)
+			}
+			incrementModifier := sdk.NewInt(blocksPerDay)
+			if toHeight == 0 {
+				toHeight = cudoMint.EmissionEndHeight(incrementModifier, normTimePassed)
+			}
+			if step == 0 {
+				step = blocksPerDay
+			}
+
+			schedule, err := cudoMint.EmissionSchedule(incrementModifier, normTimePassed, fromHeight, toHeight, step)
+			if err != nil {
+				return err
+			}
+			for i := range schedule {
+				schedule[i].Supply = schedule[i].Supply.Add(initialSupply)
+			}
+
+			switch output {
+			case "csv":
+				w := csv.NewWriter(cmd.OutOrStdout())
+				if err := w.Write([]string{"height", "norm_time_passed", "minted", "supply"}); err != nil {
+					return err
+				}
+				for _, point := range schedule {
+					if err := w.Write([]string{strconv.FormatInt(point.Height, 10), point.NormTimePassed.String(), point.Minted.String(), point.Supply.String()}); err != nil {
+						return err
+					}
+				}
+				w.Flush()
+				return w.Error()
+			case "json":
+				bz, err := json.MarshalIndent(schedule, "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return err
+			default:
+				return fmt.Errorf("unknown output format %s, expected csv or json", output)
+			}
+		},
+	}
+
+	cmd.Flags().Int64(flagBlocksPerDay, cudoMinttypes.DefaultParams().IncrementModifier.Int64(), "Increment modifier param, the expected number of blocks per day")
+	cmd.Flags().Int64(flagFromHeight, 1, "First block of the schedule")
+	cmd.Flags().Int64(flagToHeight, 0, "Last block of the schedule, defaults to the block after the emission ends")
+	cmd.Flags().Int64(flagStep, 0, "Number of blocks between the rows, defaults to --blocks-per-day")
+	cmd.Flags().String(flagNormTimePassed, "0", "Normalized time passed of the minter before the first block")
+	cmd.Flags().String(flagInitialSupply, "0", fmt.Sprintf("Supply in %s before the first block", cudoMinttypes.MintDenom))
+	cmd.Flags().String(tmcli.OutputFlag, "csv", "Output format (csv|json)")
+
+	return cmd
+}
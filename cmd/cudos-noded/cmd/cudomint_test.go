@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CudoVentures/cudos-node/x/cudoMint"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func execScheduleCmd(args ...string) (string, error) {
+	cmd := CudoMintScheduleCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(ioutil.Discard)
+	cmd.SetArgs(args)
+	cmd.SilenceUsage = true
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestCudoMintScheduleCSV(t *testing.T) {
+	out, err := execScheduleCmd("--blocks-per-day", "100", "--from-height", "1", "--to-height", "250", "--initial-supply", "1000")
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(bytes.NewBufferString(out)).ReadAll()
+	require.NoError(t, err)
+
+	schedule, err := cudoMint.EmissionSchedule(sdk.NewInt(100), sdk.ZeroDec(), 1, 250, 100)
+	require.NoError(t, err)
+	require.Len(t, rows, len(schedule)+1)
+	require.Equal(t, []string{"height", "norm_time_passed", "minted", "supply"}, rows[0])
+	for i, point := range schedule {
+		require.Equal(t, []string{
+			sdk.NewInt(point.Height).String(),
+			point.NormTimePassed.String(),
+			point.Minted.String(),
+			point.Supply.AddRaw(1000).String(),
+		}, rows[i+1])
+	}
+}
+
+func TestCudoMintScheduleJSON(t *testing.T) {
+	out, err := execScheduleCmd("--blocks-per-day", "100", "--norm-time-passed", "9.99", "--output", "json")
+	require.NoError(t, err)
+
+	var schedule []cudoMint.EmissionPoint
+	require.NoError(t, json.Unmarshal([]byte(out), &schedule))
+	require.NotEmpty(t, schedule)
+
+	// by default the schedule ends with the first block that mints nothing
+	endHeight := cudoMint.EmissionEndHeight(sdk.NewInt(100), sdk.MustNewDecFromStr("9.99"))
+	last := schedule[len(schedule)-1]
+	require.Equal(t, endHeight, last.Height)
+	require.True(t, last.Minted.IsZero())
+	require.True(t, schedule[len(schedule)-2].Minted.IsPositive())
+}
+
+func TestCudoMintScheduleInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		args []string
+		err  string
+	}{
+		{args: []string{"--norm-time-passed", "soon"}, err: "invalid norm-time-passed"},
+		{args: []string{"--initial-supply", "-1"}, err: "invalid initial-supply"},
+		{args: []string{"--blocks-per-day", "0"}, err: "blocks-per-day must be positive"},
+		{args: []string{"--from-height", "10", "--to-height", "5"}, err: "invalid height range 10 to 5"},
+		{args: []string{"--output", "text"}, err: "unknown output format text"},
+	} {
+		_, err := execScheduleCmd(tc.args...)
+		require.ErrorContains(t, err, tc.err, tc.args)
+	}
+}
+
+func TestCudoMintScheduleWithoutNodeHome(t *testing.T) {
+	home := filepath.Join(t.TempDir(), "cudos-data")
+
+	// run through the root command as the binary does
+	rootCmd, _ := NewRootCmd()
+	rootCmd.PersistentFlags().String(flags.FlagHome, home, "")
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetArgs([]string{"cudomint", "schedule", "--blocks-per-day", "100", "--to-height", "250"})
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+	ctx = context.WithValue(ctx, server.ServerContextKey, server.NewDefaultContext())
+	require.NoError(t, rootCmd.ExecuteContext(ctx))
+	require.NotEmpty(t, out.String())
+
+	// the offline command neither reads nor writes the config of a node
+	require.NoDirExists(t, home)
+}
@@ -3,7 +3,9 @@ package cmd
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/spf13/cobra"
 
@@ -14,11 +16,40 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
+const (
+	flagVestingStart    = "vesting-start-time"
+	flagVestingEnd      = "vesting-end-time"
+	flagVestingAmt      = "vesting-amount"
+	flagVestingSchedule = "vesting-schedule"
+)
+
+// VestingSchedule is the periodic vesting schedule file read by add-genesis-account.
+type VestingSchedule struct {
+	StartTime int64           `json:"start_time"`
+	Periods   []VestingPeriod `json:"periods"`
+}
+
+// VestingPeriod unlocks coins length_seconds after the previous period.
+type VestingPeriod struct {
+	Coins         string `json:"coins"`
+	LengthSeconds int64  `json:"length_seconds"`
+}
+
+// vestingOptions selects the kind of vesting account a genesis account gets.
+type vestingOptions struct {
+	Amount    sdk.Coins
+	StartTime int64
+	EndTime   int64
+	Schedule  *VestingSchedule
+}
+
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
 func AddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -28,38 +59,33 @@ func AddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
 the account address or key name and a list of initial coins. If a key name is given,
 the address will be looked up in the local Keybase. The list of initial tokens must
 contain valid denominations.
+
+Vesting accounts are created with --vesting-amount: a continuous vesting account
+when both --vesting-start-time and --vesting-end-time are given and a delayed
+vesting account when only the end time is given. A periodic vesting account is
+created from a --vesting-schedule file:
+
+{
+  "start_time": 1672531200,
+  "periods": [
+    {"coins": "1000000acudos", "length_seconds": 2592000},
+    {"coins": "1000000acudos", "length_seconds": 2592000}
+  ]
+}
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
-			depCdc := clientCtx.JSONCodec
-			cdc := depCdc.(codec.Codec)
+			cdc := clientCtx.JSONCodec.(codec.Codec)
 
 			serverCtx := server.GetServerContextFromCmd(cmd)
 			config := serverCtx.Config
 
 			config.SetRoot(clientCtx.HomeDir)
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := newAddressResolver(cmd, clientCtx).resolve(args[0])
 			if err != nil {
-				inBuf := bufio.NewReader(cmd.InOrStdin())
-				keyringBackend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
-				if err != nil {
-					return err
-				}
-
-				// attempt to lookup address from Keybase if no address was provided
-				kb, err := keyring.New(sdk.KeyringServiceName(), keyringBackend, clientCtx.HomeDir, inBuf)
-				if err != nil {
-					return err
-				}
-
-				info, err := kb.Key(args[0])
-				if err != nil {
-					return fmt.Errorf("failed to get address from Keybase: %w", err)
-				}
-
-				addr = info.GetAddress()
+				return err
 			}
 
 			coins, err := sdk.ParseCoinsNormalized(args[1])
@@ -67,75 +93,253 @@ contain valid denominations.
 				return fmt.Errorf("failed to parse coins: %w", err)
 			}
 
-			// create concrete account type based on input parameters
-			var genAccount authtypes.GenesisAccount
-
-			balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
-			baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
-			genAccount = baseAccount
-
-			if err := genAccount.Validate(); err != nil {
-				return fmt.Errorf("failed to validate new genesis account: %w", err)
+			var vesting vestingOptions
+			if vesting.StartTime, err = cmd.Flags().GetInt64(flagVestingStart); err != nil {
+				return err
 			}
-
-			genFile := config.GenesisFile()
-			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
-			if err != nil {
-				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			if vesting.EndTime, err = cmd.Flags().GetInt64(flagVestingEnd); err != nil {
+				return err
 			}
-
-			authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
-
-			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			vestingAmtStr, err := cmd.Flags().GetString(flagVestingAmt)
 			if err != nil {
-				return fmt.Errorf("failed to get accounts from any: %w", err)
+				return err
 			}
-
-			if accs.Contains(addr) {
-				return fmt.Errorf("cannot add account at existing address %s", addr)
+			if vesting.Amount, err = sdk.ParseCoinsNormalized(vestingAmtStr); err != nil {
+				return fmt.Errorf("failed to parse vesting amount: %w", err)
 			}
 
-			// Add the new account to the set of genesis accounts and sanitize the
-			// accounts afterwards.
-			accs = append(accs, genAccount)
-			accs = authtypes.SanitizeGenesisAccounts(accs)
-
-			genAccs, err := authtypes.PackAccounts(accs)
-			if err != nil {
-				return fmt.Errorf("failed to convert accounts into any's: %w", err)
-			}
-			authGenState.Accounts = genAccs
-
-			authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+			scheduleFile, err := cmd.Flags().GetString(flagVestingSchedule)
 			if err != nil {
-				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+				return err
 			}
-
-			appState[authtypes.ModuleName] = authGenStateBz
-
-			bankGenState := banktypes.GetGenesisStateFromAppState(depCdc, appState)
-			bankGenState.Balances = append(bankGenState.Balances, balances)
-			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
-
-			bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
-			if err != nil {
-				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+			if scheduleFile != "" {
+				if vesting.Schedule, err = readVestingSchedule(scheduleFile); err != nil {
+					return err
+				}
 			}
 
-			appState[banktypes.ModuleName] = bankGenStateBz
-
-			appStateJSON, err := json.Marshal(appState)
+			genAccount, err := newGenesisAccount(addr, coins, vesting)
 			if err != nil {
-				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+				return err
 			}
 
-			genDoc.AppState = appStateJSON
-			return genutil.ExportGenesisFile(genDoc, genFile)
+			balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
+			return addGenesisAccounts(cdc, config.GenesisFile(), []authtypes.GenesisAccount{genAccount}, []banktypes.Balance{balances}, false)
 		},
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
+	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
+	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingSchedule, "", "periodic vesting schedule file (JSON) for periodic vesting accounts")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// addressResolver parses bech32 addresses and looks key names up in the
+// keyring, which is only opened once a key name is seen.
+type addressResolver struct {
+	cmd       *cobra.Command
+	clientCtx client.Context
+	kb        keyring.Keyring
+}
+
+func newAddressResolver(cmd *cobra.Command, clientCtx client.Context) *addressResolver {
+	return &addressResolver{cmd: cmd, clientCtx: clientCtx}
+}
+
+func (r *addressResolver) resolve(addressOrKeyName string) (sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(addressOrKeyName)
+	if err == nil {
+		return addr, nil
+	}
+
+	if r.kb == nil {
+		inBuf := bufio.NewReader(r.cmd.InOrStdin())
+		keyringBackend, err := r.cmd.Flags().GetString(flags.FlagKeyringBackend)
+		if err != nil {
+			return nil, err
+		}
+
+		// attempt to lookup address from Keybase if no address was provided
+		r.kb, err = keyring.New(sdk.KeyringServiceName(), keyringBackend, r.clientCtx.HomeDir, inBuf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	info, err := r.kb.Key(addressOrKeyName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address from Keybase: %w", err)
+	}
+
+	return info.GetAddress(), nil
+}
+
+// newGenesisAccount creates the concrete account type selected by the vesting options.
+func newGenesisAccount(addr sdk.AccAddress, coins sdk.Coins, vesting vestingOptions) (authtypes.GenesisAccount, error) {
+	var genAccount authtypes.GenesisAccount
+	var err error
+
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+
+	switch {
+	case vesting.Schedule != nil:
+		if vesting.EndTime != 0 {
+			return nil, errors.New("the vesting end time of a periodic vesting account follows from its schedule")
+		}
+
+		genAccount, err = newPeriodicVestingAccount(baseAccount, *vesting.Schedule, vesting.StartTime, vesting.Amount)
+		if err != nil {
+			return nil, err
+		}
+	case !vesting.Amount.IsZero():
+		baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vesting.Amount.Sort(), vesting.EndTime)
+
+		switch {
+		case vesting.StartTime != 0 && vesting.EndTime != 0:
+			genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vesting.StartTime)
+		case vesting.EndTime != 0:
+			genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
+		default:
+			return nil, errors.New("invalid vesting parameters; must supply start and end time or end time")
+		}
+	default:
+		genAccount = baseAccount
+	}
+
+	if vestingAcc, ok := genAccount.(vestexported.VestingAccount); ok {
+		if !vestingAcc.GetOriginalVesting().IsAllLTE(coins) {
+			return nil, errors.New("vesting amount cannot be greater than total amount")
+		}
+	}
+
+	if err := genAccount.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate new genesis account: %w", err)
+	}
+
+	return genAccount, nil
+}
+
+func readVestingSchedule(scheduleFile string) (*VestingSchedule, error) {
+	bz, err := ioutil.ReadFile(scheduleFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vesting schedule: %w", err)
+	}
+
+	var schedule VestingSchedule
+	if err := json.Unmarshal(bz, &schedule); err != nil {
+		return nil, fmt.Errorf("failed to parse vesting schedule: %w", err)
+	}
+
+	return &schedule, nil
+}
+
+// newPeriodicVestingAccount creates a periodic vesting account from a
+// schedule. A non-zero start time overrides the one of the schedule and the
+// vesting amount, when given, must match the sum of the periods.
+func newPeriodicVestingAccount(baseAccount *authtypes.BaseAccount, schedule VestingSchedule, startTime int64, vestingAmt sdk.Coins) (*authvesting.PeriodicVestingAccount, error) {
+	if startTime == 0 {
+		startTime = schedule.StartTime
+	}
+	if startTime <= 0 {
+		return nil, errors.New("the vesting schedule needs a positive start time")
+	}
+	if len(schedule.Periods) == 0 {
+		return nil, errors.New("the vesting schedule has no periods")
+	}
+
+	var periods authvesting.Periods
+	originalVesting := sdk.NewCoins()
+	for i, p := range schedule.Periods {
+		if p.LengthSeconds <= 0 {
+			return nil, fmt.Errorf("vesting period %d must have a positive length", i)
+		}
+
+		periodCoins, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse coins of vesting period %d: %w", i, err)
+		}
+
+		periods = append(periods, authvesting.Period{Length: p.LengthSeconds, Amount: periodCoins})
+		originalVesting = originalVesting.Add(periodCoins...)
+	}
+
+	// IsEqual panics on coins of different denoms
+	if !vestingAmt.IsZero() && !(vestingAmt.IsAllLTE(originalVesting) && originalVesting.IsAllLTE(vestingAmt)) {
+		return nil, fmt.Errorf("vesting amount %s does not match the schedule total %s", vestingAmt, originalVesting)
+	}
+
+	return authvesting.NewPeriodicVestingAccount(baseAccount, originalVesting, startTime, periods), nil
+}
+
+// addGenesisAccounts adds the accounts and their balances to the auth and
+// bank genesis in a single rewrite of the genesis file. With updateSupply a
+// supply set in the bank genesis grows by the new balances.
+func addGenesisAccounts(cdc codec.Codec, genFile string, newAccounts []authtypes.GenesisAccount, newBalances []banktypes.Balance, updateSupply bool) error {
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	for _, genAccount := range newAccounts {
+		if accs.Contains(genAccount.GetAddress()) {
+			return fmt.Errorf("cannot add account at existing address %s", genAccount.GetAddress())
+		}
+
+		// Add the new account to the set of genesis accounts
+		accs = append(accs, genAccount)
+	}
+
+	// sanitize the accounts afterwards.
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	bankGenState.Balances = append(bankGenState.Balances, newBalances...)
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+	// a supply set in genesis has to keep matching the balances
+	if updateSupply && !bankGenState.Supply.IsZero() {
+		for _, balance := range newBalances {
+			bankGenState.Supply = bankGenState.Supply.Add(balance.Coins...)
+		}
+	}
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	appState[banktypes.ModuleName] = bankGenStateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	genDoc.AppState = appStateJSON
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
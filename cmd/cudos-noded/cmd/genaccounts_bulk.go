@@ -0,0 +1,343 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagDryRun    = "dry-run"
+	flagMaxSupply = "max-supply"
+)
+
+// BulkGenesisAccount is a row of the file read by add-genesis-accounts-bulk.
+type BulkGenesisAccount struct {
+	Address          string           `json:"address"`
+	Coins            string           `json:"coins"`
+	VestingAmount    string           `json:"vesting_amount,omitempty"`
+	VestingStartTime int64            `json:"vesting_start_time,omitempty"`
+	VestingEndTime   int64            `json:"vesting_end_time,omitempty"`
+	VestingSchedule  *VestingSchedule `json:"vesting_schedule,omitempty"`
+}
+
+// csvColumns are the columns of a CSV accounts file, the vesting ones are optional.
+var csvColumns = []string{"address", "coins", "vesting_amount", "vesting_start_time", "vesting_end_time"}
+
+// AddGenesisAccountsBulkCmd returns add-genesis-accounts-bulk cobra Command.
+func AddGenesisAccountsBulkCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-accounts-bulk [file]",
+		Short: "Add the genesis accounts listed in a CSV or JSON file to genesis.json",
+		Long: `Add the genesis accounts listed in a CSV or JSON file to genesis.json in a
+single pass. Each row gives an account address or key name, its coins and
+optionally the same vesting parameters as add-genesis-account.
+
+A .csv file starts with a header naming its columns:
+
+address,coins,vesting_amount,vesting_start_time,vesting_end_time
+cudos1...,1000000acudos,,,
+validator,5000000acudos,2000000acudos,1672531200,1704067200
+
+A .json file holds an array of rows, which may also define a periodic
+vesting schedule:
+
+[
+  {"address": "cudos1...", "coins": "1000000acudos"},
+  {"address": "cudos1...", "coins": "2000000acudos",
+   "vesting_schedule": {"start_time": 1672531200, "periods": [{"coins": "2000000acudos", "length_seconds": 2592000}]}}
+]
+
+The file is rejected as a whole when an address appears twice or already has
+an account in genesis, or when the resulting supply exceeds --max-supply. A
+supply set in the bank genesis is increased by the added balances. With
+--dry-run the supply per denom is reported without writing genesis.json.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.JSONCodec.(codec.Codec)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			rows, err := readBulkGenesisAccounts(args[0])
+			if err != nil {
+				return err
+			}
+
+			maxSupplyStr, err := cmd.Flags().GetString(flagMaxSupply)
+			if err != nil {
+				return err
+			}
+			maxSupply, err := sdk.ParseCoinsNormalized(maxSupplyStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse max supply: %w", err)
+			}
+
+			resolver := newAddressResolver(cmd, clientCtx)
+			seen := make(map[string]int)
+
+			var (
+				accounts []authtypes.GenesisAccount
+				balances []banktypes.Balance
+			)
+			for i, row := range rows {
+				// rows are numbered as in the file, after the CSV header
+				rowNum := i + 1
+
+				addr, err := resolver.resolve(row.Address)
+				if err != nil {
+					return fmt.Errorf("row %d: %w", rowNum, err)
+				}
+				if prev, ok := seen[addr.String()]; ok {
+					return fmt.Errorf("row %d: address %s is already listed in row %d", rowNum, addr, prev)
+				}
+				seen[addr.String()] = rowNum
+
+				genAccount, coins, err := row.genesisAccount(addr)
+				if err != nil {
+					return fmt.Errorf("row %d: %w", rowNum, err)
+				}
+
+				accounts = append(accounts, genAccount)
+				balances = append(balances, banktypes.Balance{Address: addr.String(), Coins: coins.Sort()})
+			}
+
+			summary, err := summarizeBulkGenesisAccounts(cdc, config.GenesisFile(), accounts, balances)
+			if err != nil {
+				return err
+			}
+
+			for _, coin := range maxSupply {
+				if total := summary.Total.AmountOf(coin.Denom); total.GT(coin.Amount) {
+					return fmt.Errorf("total supply of %s%s exceeds the maximum of %s", total, coin.Denom, coin)
+				}
+			}
+
+			dryRun, err := cmd.Flags().GetBool(flagDryRun)
+			if err != nil {
+				return err
+			}
+			if !dryRun {
+				if err := addGenesisAccounts(cdc, config.GenesisFile(), accounts, balances, true); err != nil {
+					return err
+				}
+			}
+
+			summary.print(cmd.OutOrStdout(), dryRun)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().Bool(flagDryRun, false, "Validate the file and report the supply per denom without writing genesis.json")
+	cmd.Flags().String(flagMaxSupply, "", "Maximum total supply per denom after adding the accounts, e.g. 10000000000000000000000000000acudos")
+
+	return cmd
+}
+
+func readBulkGenesisAccounts(file string) ([]BulkGenesisAccount, error) {
+	switch ext := strings.ToLower(filepath.Ext(file)); ext {
+	case ".json":
+		bz, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read accounts file: %w", err)
+		}
+
+		var rows []BulkGenesisAccount
+		if err := json.Unmarshal(bz, &rows); err != nil {
+			return nil, fmt.Errorf("failed to parse accounts file: %w", err)
+		}
+		return rows, nil
+	case ".csv":
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read accounts file: %w", err)
+		}
+		defer f.Close()
+
+		return parseBulkGenesisAccountsCSV(f)
+	default:
+		return nil, fmt.Errorf("unsupported accounts file extension %q, expected .csv or .json", ext)
+	}
+}
+
+func parseBulkGenesisAccountsCSV(r io.Reader) ([]BulkGenesisAccount, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse accounts file: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("the accounts file has no header")
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !containsString(csvColumns, name) {
+			return nil, fmt.Errorf("unknown column %q, expected %s", name, strings.Join(csvColumns, ","))
+		}
+		columns[name] = i
+	}
+	for _, name := range csvColumns[:2] {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("the accounts file has no %s column", name)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	timestamp := func(record []string, name string) (int64, error) {
+		value := field(record, name)
+		if value == "" {
+			return 0, nil
+		}
+		return strconv.ParseInt(value, 10, 64)
+	}
+
+	rows := make([]BulkGenesisAccount, 0, len(records)-1)
+	for i, record := range records[1:] {
+		row := BulkGenesisAccount{
+			Address:       field(record, "address"),
+			Coins:         field(record, "coins"),
+			VestingAmount: field(record, "vesting_amount"),
+		}
+
+		if row.VestingStartTime, err = timestamp(record, "vesting_start_time"); err != nil {
+			return nil, fmt.Errorf("row %d: invalid vesting start time: %w", i+1, err)
+		}
+		if row.VestingEndTime, err = timestamp(record, "vesting_end_time"); err != nil {
+			return nil, fmt.Errorf("row %d: invalid vesting end time: %w", i+1, err)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// genesisAccount creates the account of the row and returns its coins.
+func (row BulkGenesisAccount) genesisAccount(addr sdk.AccAddress) (authtypes.GenesisAccount, sdk.Coins, error) {
+	coins, err := sdk.ParseCoinsNormalized(row.Coins)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse coins: %w", err)
+	}
+	if coins.IsZero() {
+		return nil, nil, fmt.Errorf("account %s has no coins", addr)
+	}
+
+	vesting := vestingOptions{
+		StartTime: row.VestingStartTime,
+		EndTime:   row.VestingEndTime,
+		Schedule:  row.VestingSchedule,
+	}
+	if vesting.Amount, err = sdk.ParseCoinsNormalized(row.VestingAmount); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse vesting amount: %w", err)
+	}
+
+	genAccount, err := newGenesisAccount(addr, coins, vesting)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return genAccount, coins, nil
+}
+
+// bulkGenesisSummary reports the supply per denom added by a bulk import.
+type bulkGenesisSummary struct {
+	Accounts int
+	Added    sdk.Coins
+	Total    sdk.Coins
+	Holders  map[string]int
+}
+
+// summarizeBulkGenesisAccounts checks the accounts against the genesis file
+// and sums the supply they add to the balances already in it.
+func summarizeBulkGenesisAccounts(cdc codec.Codec, genFile string, accounts []authtypes.GenesisAccount, balances []banktypes.Balance) (bulkGenesisSummary, error) {
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return bulkGenesisSummary{}, fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return bulkGenesisSummary{}, fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+	for _, genAccount := range accounts {
+		if accs.Contains(genAccount.GetAddress()) {
+			return bulkGenesisSummary{}, fmt.Errorf("cannot add account at existing address %s", genAccount.GetAddress())
+		}
+	}
+
+	summary := bulkGenesisSummary{
+		Accounts: len(accounts),
+		Added:    sdk.NewCoins(),
+		Total:    sdk.NewCoins(),
+		Holders:  make(map[string]int),
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	for _, balance := range bankGenState.Balances {
+		summary.Total = summary.Total.Add(balance.Coins...)
+	}
+	for _, balance := range balances {
+		summary.Added = summary.Added.Add(balance.Coins...)
+		for _, coin := range balance.Coins {
+			summary.Holders[coin.Denom]++
+		}
+	}
+	summary.Total = summary.Total.Add(summary.Added...)
+
+	return summary, nil
+}
+
+func (s bulkGenesisSummary) print(w io.Writer, dryRun bool) {
+	if dryRun {
+		fmt.Fprintf(w, "dry run: %d accounts would be added\n", s.Accounts)
+	} else {
+		fmt.Fprintf(w, "%d accounts added\n", s.Accounts)
+	}
+
+	fmt.Fprintf(w, "\n%-16s %8s %32s %32s\n", "denom", "accounts", "added", "genesis supply")
+	for _, coin := range s.Total {
+		fmt.Fprintf(w, "%-16s %8d %32s %32s\n", coin.Denom, s.Holders[coin.Denom], s.Added.AmountOf(coin.Denom), coin.Amount)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CudoVentures/cudos-node/app"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestParseBulkGenesisAccountsCSV(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		csv  string
+		rows []BulkGenesisAccount
+		err  string
+	}{
+		{
+			name: "all columns",
+			csv:  "address,coins,vesting_amount,vesting_start_time,vesting_end_time\ncudos1a,10acudos,5acudos,100,200\n",
+			rows: []BulkGenesisAccount{{Address: "cudos1a", Coins: "10acudos", VestingAmount: "5acudos", VestingStartTime: 100, VestingEndTime: 200}},
+		},
+		{
+			name: "required columns in any order",
+			csv:  "Coins, Address\n10acudos, cudos1a\n20acudos, cudos1b\n",
+			rows: []BulkGenesisAccount{{Address: "cudos1a", Coins: "10acudos"}, {Address: "cudos1b", Coins: "20acudos"}},
+		},
+		{
+			name: "empty vesting times",
+			csv:  "address,coins,vesting_amount,vesting_start_time,vesting_end_time\ncudos1a,10acudos,,,\n",
+			rows: []BulkGenesisAccount{{Address: "cudos1a", Coins: "10acudos"}},
+		},
+		{name: "empty file", csv: "", err: "no header"},
+		{name: "unknown column", csv: "address,coins,memo\n", err: "unknown column"},
+		{name: "missing coins column", csv: "address\ncudos1a\n", err: "no coins column"},
+		{name: "invalid vesting time", csv: "address,coins,vesting_end_time\ncudos1a,10acudos,soon\n", err: "row 1: invalid vesting end time"},
+		{name: "missing field", csv: "address,coins\ncudos1a\n", err: "failed to parse accounts file"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rows, err := parseBulkGenesisAccountsCSV(strings.NewReader(tc.csv))
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.rows, rows)
+		})
+	}
+}
+
+func writeAccountsFile(t *testing.T, name, content string) string {
+	file := filepath.Join(t.TempDir(), name)
+	require.NoError(t, ioutil.WriteFile(file, []byte(content), 0o600))
+	return file
+}
+
+// setGenesisSupply sets the bank genesis supply of home to the sum of its balances.
+func setGenesisSupply(t *testing.T, home string) {
+	cdc := app.MakeEncodingConfig().Codec
+	genFile := filepath.Join(home, "config", "genesis.json")
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	require.NoError(t, err)
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	bankGenState.Supply = sdk.NewCoins()
+	for _, balance := range bankGenState.Balances {
+		bankGenState.Supply = bankGenState.Supply.Add(balance.Coins...)
+	}
+	appState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenState)
+
+	genDoc.AppState, err = json.Marshal(appState)
+	require.NoError(t, err)
+	require.NoError(t, genutil.ExportGenesisFile(genDoc, genFile))
+}
+
+func genesisBankState(t *testing.T, home string) *banktypes.GenesisState {
+	return banktypes.GetGenesisStateFromAppState(app.MakeEncodingConfig().Codec, genesisAppState(t, home))
+}
+
+func TestAddGenesisAccountsBulk(t *testing.T) {
+	first := sdk.AccAddress([]byte("first_______________"))
+	second := sdk.AccAddress([]byte("second______________"))
+	accounts := writeAccountsFile(t, "accounts.csv", "address,coins,vesting_amount,vesting_start_time,vesting_end_time\n"+
+		first.String()+",1000acudos,,,\n"+
+		second.String()+",2000acudos,500acudos,1672531200,1704067200\n")
+
+	t.Run("dry run", func(t *testing.T) {
+		home := setupGenesisHome(t)
+		cmd := AddGenesisAccountsBulkCmd(home)
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		require.NoError(t, execGenesisCmd(home, cmd, accounts, "--dry-run"))
+
+		require.Contains(t, out.String(), "dry run: 2 accounts would be added")
+		require.Regexp(t, `acudos\s+2\s+3000\s+3000`, out.String())
+		require.Empty(t, genesisAccounts(t, home))
+	})
+
+	t.Run("import", func(t *testing.T) {
+		home := setupGenesisHome(t)
+		require.NoError(t, execGenesisCmd(home, AddGenesisAccountsBulkCmd(home), accounts))
+
+		accs := genesisAccounts(t, home)
+		require.Len(t, accs, 2)
+		vestingAcc, ok := accs[1].(*authvesting.ContinuousVestingAccount)
+		require.True(t, ok)
+		require.Equal(t, second, vestingAcc.GetAddress())
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("acudos", 500)), vestingAcc.OriginalVesting)
+		require.Len(t, genesisBankState(t, home).Balances, 2)
+		require.Empty(t, genesisBankState(t, home).Supply)
+
+		// importing the same file again would add existing addresses
+		require.ErrorContains(t, execGenesisCmd(home, AddGenesisAccountsBulkCmd(home), accounts), "existing address")
+	})
+
+	t.Run("supply", func(t *testing.T) {
+		home := setupGenesisHome(t)
+		require.NoError(t, execGenesisCmd(home, AddGenesisAccountCmd(home), sdk.AccAddress([]byte("existing____________")).String(), "100acudos"))
+		setGenesisSupply(t, home)
+
+		require.ErrorContains(t, execGenesisCmd(home, AddGenesisAccountsBulkCmd(home), accounts, "--max-supply", "3000acudos"), "exceeds the maximum")
+		require.NoError(t, execGenesisCmd(home, AddGenesisAccountsBulkCmd(home), accounts, "--max-supply", "3100acudos"))
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("acudos", 3100)), genesisBankState(t, home).Supply)
+	})
+
+	t.Run("duplicate address", func(t *testing.T) {
+		home := setupGenesisHome(t)
+		duplicates := writeAccountsFile(t, "accounts.json", `[
+			{"address": "`+first.String()+`", "coins": "1000acudos"},
+			{"address": "`+first.String()+`", "coins": "2000acudos"}
+		]`)
+
+		require.ErrorContains(t, execGenesisCmd(home, AddGenesisAccountsBulkCmd(home), duplicates), "row 2: address "+first.String()+" is already listed in row 1")
+		require.Empty(t, genesisAccounts(t, home))
+	})
+
+	t.Run("invalid row", func(t *testing.T) {
+		home := setupGenesisHome(t)
+		invalid := writeAccountsFile(t, "accounts.json", `[
+			{"address": "`+first.String()+`", "coins": "1000acudos"},
+			{"address": "`+second.String()+`", "coins": "1000acudos", "vesting_amount": "2000acudos", "vesting_end_time": 1704067200}
+		]`)
+
+		require.ErrorContains(t, execGenesisCmd(home, AddGenesisAccountsBulkCmd(home), invalid), "row 2: vesting amount cannot be greater than total amount")
+		require.Empty(t, genesisAccounts(t, home))
+	})
+}
+
+func TestAddGenesisAccountKeepsSupply(t *testing.T) {
+	home := setupGenesisHome(t)
+	require.NoError(t, execGenesisCmd(home, AddGenesisAccountCmd(home), sdk.AccAddress([]byte("first_______________")).String(), "100acudos"))
+	setGenesisSupply(t, home)
+
+	require.NoError(t, execGenesisCmd(home, AddGenesisAccountCmd(home), sdk.AccAddress([]byte("second______________")).String(), "100acudos"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("acudos", 100)), genesisBankState(t, home).Supply)
+}
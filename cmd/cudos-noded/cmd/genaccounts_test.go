@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/CudoVentures/cudos-node/app"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestMain(m *testing.M) {
+	app.SetConfig()
+	os.Exit(m.Run())
+}
+
+// setupGenesisHome creates a node home with the default genesis of the app.
+func setupGenesisHome(t *testing.T) string {
+	home := t.TempDir()
+	require.NoError(t, genutiltest.ExecInitCmd(app.ModuleBasics, home, app.MakeEncodingConfig().Codec))
+	return home
+}
+
+// execGenesisCmd runs a command editing the genesis.json of home.
+func execGenesisCmd(home string, cmd *cobra.Command, args ...string) error {
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	if err != nil {
+		return err
+	}
+
+	serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+	encodingConfig := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Codec).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithHomeDir(home)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	cmd.SetArgs(args)
+	cmd.SetErr(ioutil.Discard)
+	cmd.SilenceUsage = true
+	return cmd.ExecuteContext(ctx)
+}
+
+// genesisAppState returns the app state of the genesis.json of home.
+func genesisAppState(t *testing.T, home string) map[string]json.RawMessage {
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	return appState
+}
+
+// genesisAccounts returns the auth genesis accounts of home.
+func genesisAccounts(t *testing.T, home string) authtypes.GenesisAccounts {
+	appState := genesisAppState(t, home)
+	authGenState := authtypes.GetGenesisStateFromAppState(app.MakeEncodingConfig().Codec, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	return accs
+}
+
+func writeVestingSchedule(t *testing.T, dir string) string {
+	file := filepath.Join(dir, "schedule.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`{
+		"start_time": 1672531200,
+		"periods": [
+			{"coins": "600acudos", "length_seconds": 2592000},
+			{"coins": "400acudos", "length_seconds": 2592000}
+		]
+	}`), 0o600))
+	return file
+}
+
+func TestAddGenesisAccountVestingSchedule(t *testing.T) {
+	home := setupGenesisHome(t)
+	schedule := writeVestingSchedule(t, t.TempDir())
+	addr := sdk.AccAddress([]byte("vesting_____________"))
+
+	require.NoError(t, execGenesisCmd(home, AddGenesisAccountCmd(home),
+		addr.String(), "1000acudos", "--vesting-schedule", schedule, "--vesting-amount", "1000acudos"))
+
+	accs := genesisAccounts(t, home)
+	require.Len(t, accs, 1)
+	acc, ok := accs[0].(*authvesting.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, addr, acc.GetAddress())
+	require.Equal(t, int64(1672531200), acc.StartTime)
+	require.Equal(t, int64(1672531200+2*2592000), acc.EndTime)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("acudos", 1000)), acc.OriginalVesting)
+}
+
+func TestAddGenesisAccountVestingScheduleMismatch(t *testing.T) {
+	home := setupGenesisHome(t)
+	schedule := writeVestingSchedule(t, t.TempDir())
+	addr := sdk.AccAddress([]byte("vesting_____________"))
+
+	for _, vestingAmt := range []string{"100foo", "999acudos", "1000acudos,100foo"} {
+		err := execGenesisCmd(home, AddGenesisAccountCmd(home),
+			addr.String(), "1000acudos", "--vesting-schedule", schedule, "--vesting-amount", vestingAmt)
+		require.ErrorContains(t, err, "does not match the schedule total", vestingAmt)
+	}
+	require.Empty(t, genesisAccounts(t, home))
+}
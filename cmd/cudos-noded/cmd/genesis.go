@@ -0,0 +1,505 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/CudoVentures/cudos-node/app"
+	admintypes "github.com/CudoVentures/cudos-node/x/admin/types"
+	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	flagIncrementModifier = "increment-modifier"
+	flagMintRemainder     = "mint-remainder"
+	flagNormTimePassed    = "norm-time-passed"
+	flagGoalBonded        = "goal-bonded"
+	flagEmissionFactorMin = "emission-factor-min"
+	flagEmissionFactorMax = "emission-factor-max"
+	flagAmount            = "amount"
+)
+
+// GenesisCmd returns the commands editing and checking the Cudos specific
+// module state of genesis.json.
+func GenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Edit and check the Cudos module state of genesis.json",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cudoMintCmd := &cobra.Command{
+		Use:                        "cudomint",
+		Short:                      "Edit the cudoMint genesis state",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cudoMintCmd.AddCommand(
+		GenesisCudoMintSetParamsCmd(defaultNodeHome),
+		GenesisCudoMintSetMinterCmd(defaultNodeHome),
+	)
+
+	adminCmd := &cobra.Command{
+		Use:                        "admin",
+		Short:                      "Edit the admin roles of genesis",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	adminCmd.AddCommand(GenesisAdminAddRoleCmd(defaultNodeHome))
+
+	cmd.AddCommand(
+		cudoMintCmd,
+		adminCmd,
+		GenesisCheckCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// GenesisCudoMintSetParamsCmd returns a command setting the cudoMint params in genesis.json.
+func GenesisCudoMintSetParamsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-params",
+		Short: "Set the cudoMint params in genesis.json",
+		Long: `Set the cudoMint params in genesis.json. Only the params given as flags are
+changed. The increment modifier is the expected number of blocks per day. The
+min and max emission factors scale the emission when all tokens and when no
+tokens are bonded, the staking feedback is disabled when both are 1.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return editCudoMintGenesis(cmd, func(genState *cudoMinttypes.GenesisState) error {
+				if cmd.Flags().Changed(flagIncrementModifier) {
+					value, _ := cmd.Flags().GetString(flagIncrementModifier)
+					incrementModifier, ok := sdk.NewIntFromString(value)
+					if !ok {
+						return fmt.Errorf("invalid increment modifier %s", value)
+					}
+					genState.Params.IncrementModifier = incrementModifier
+				}
+
+				for flag, field := range map[string]*sdk.Dec{
+					flagGoalBonded:        &genState.Params.GoalBonded,
+					flagEmissionFactorMin: &genState.Params.EmissionFactorMin,
+					flagEmissionFactorMax: &genState.Params.EmissionFactorMax,
+				} {
+					if !cmd.Flags().Changed(flag) {
+						continue
+					}
+
+					value, _ := cmd.Flags().GetString(flag)
+					dec, err := sdk.NewDecFromStr(value)
+					if err != nil {
+						return fmt.Errorf("invalid %s: %w", flag, err)
+					}
+					*field = dec
+				}
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagIncrementModifier, "", "Expected number of blocks per day")
+	cmd.Flags().String(flagGoalBonded, "", "Bonded ratio the staking feedback steers towards")
+	cmd.Flags().String(flagEmissionFactorMin, "", "Factor scaling the emission when all tokens are bonded")
+	cmd.Flags().String(flagEmissionFactorMax, "", "Factor scaling the emission when no tokens are bonded")
+
+	return cmd
+}
+
+// GenesisCudoMintSetMinterCmd returns a command setting the cudoMint minter in genesis.json.
+func GenesisCudoMintSetMinterCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-minter",
+		Short: "Set the cudoMint minter in genesis.json",
+		Long: `Set the cudoMint minter in genesis.json. Only the fields given as flags are
+changed. The normalized time passed is the progress of the 10 year emission
+curve, from 0 to 10.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return editCudoMintGenesis(cmd, func(genState *cudoMinttypes.GenesisState) error {
+				for flag, field := range map[string]*sdk.Dec{
+					flagMintRemainder:  &genState.Minter.MintRemainder,
+					flagNormTimePassed: &genState.Minter.NormTimePassed,
+				} {
+					if !cmd.Flags().Changed(flag) {
+						continue
+					}
+
+					value, _ := cmd.Flags().GetString(flag)
+					dec, err := sdk.NewDecFromStr(value)
+					if err != nil {
+						return fmt.Errorf("invalid %s: %w", flag, err)
+					}
+					*field = dec
+				}
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagMintRemainder, "", "Fraction of a token left over from the last minting")
+	cmd.Flags().String(flagNormTimePassed, "", "Normalized time passed on the emission curve")
+
+	return cmd
+}
+
+func editCudoMintGenesis(cmd *cobra.Command, edit func(genState *cudoMinttypes.GenesisState) error) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	cdc := clientCtx.JSONCodec.(codec.Codec)
+
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	config.SetRoot(clientCtx.HomeDir)
+
+	return editGenesis(config.GenesisFile(), func(appState map[string]json.RawMessage) error {
+		genState := cudoMinttypes.DefaultGenesis()
+		if bz, ok := appState[cudoMinttypes.ModuleName]; ok {
+			if err := cdc.UnmarshalJSON(bz, genState); err != nil {
+				return fmt.Errorf("failed to unmarshal %s genesis state: %w", cudoMinttypes.ModuleName, err)
+			}
+		}
+
+		if err := edit(genState); err != nil {
+			return err
+		}
+		if err := genState.Validate(); err != nil {
+			return fmt.Errorf("invalid %s genesis state: %w", cudoMinttypes.ModuleName, err)
+		}
+
+		bz, err := cdc.MarshalJSON(genState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s genesis state: %w", cudoMinttypes.ModuleName, err)
+		}
+		appState[cudoMinttypes.ModuleName] = bz
+
+		return nil
+	})
+}
+
+// GenesisAdminAddRoleCmd returns a command granting the admin role in genesis.json.
+func GenesisAdminAddRoleCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-role [address_or_key_name]",
+		Short: "Grant the admin role to an account in genesis.json",
+		Long: fmt.Sprintf(`Grant the admin role to an account in genesis.json. Admins are the accounts
+holding %[1]s tokens, so the command adds --amount %[1]s to the balance of the
+account, creating the account when genesis does not have it yet.`, admintypes.AdminDenom),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.JSONCodec.(codec.Codec)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			addr, err := newAddressResolver(cmd, clientCtx).resolve(args[0])
+			if err != nil {
+				return err
+			}
+
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok || !amount.IsPositive() {
+				return fmt.Errorf("the amount of %s must be a positive integer, got %s", admintypes.AdminDenom, amountStr)
+			}
+			roleCoins := sdk.NewCoins(sdk.NewCoin(admintypes.AdminDenom, amount))
+
+			return editGenesis(config.GenesisFile(), func(appState map[string]json.RawMessage) error {
+				authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+				accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+				if err != nil {
+					return fmt.Errorf("failed to get accounts from any: %w", err)
+				}
+
+				if moduleName := moduleAccountName(addr); moduleName != "" {
+					return fmt.Errorf("cannot grant the admin role to module account %s", moduleName)
+				}
+
+				if !accs.Contains(addr) {
+					accs = authtypes.SanitizeGenesisAccounts(append(accs, authtypes.NewBaseAccount(addr, nil, 0, 0)))
+					if authGenState.Accounts, err = authtypes.PackAccounts(accs); err != nil {
+						return fmt.Errorf("failed to convert accounts into any's: %w", err)
+					}
+					if appState[authtypes.ModuleName], err = cdc.MarshalJSON(&authGenState); err != nil {
+						return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+					}
+				}
+
+				bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+				found := false
+				for i, balance := range bankGenState.Balances {
+					if balance.Address == addr.String() {
+						bankGenState.Balances[i].Coins = balance.Coins.Add(roleCoins...)
+						found = true
+					}
+				}
+				if !found {
+					bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr.String(), Coins: roleCoins})
+				}
+				bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+				if !bankGenState.Supply.IsZero() {
+					bankGenState.Supply = bankGenState.Supply.Add(roleCoins...)
+				}
+				if err := bankGenState.Validate(); err != nil {
+					return fmt.Errorf("invalid bank genesis state: %w", err)
+				}
+
+				if appState[banktypes.ModuleName], err = cdc.MarshalJSON(bankGenState); err != nil {
+					return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+				}
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagAmount, "1", fmt.Sprintf("Amount of %s tokens to grant", admintypes.AdminDenom))
+
+	return cmd
+}
+
+// GenesisCheckCmd returns a command cross-validating the Cudos module state of genesis.json.
+func GenesisCheckCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Cross-validate the mint denom, module accounts and admin roles of genesis.json",
+		Long: `Validate the genesis state of every module and cross-validate the state
+validate-genesis cannot see from a single module:
+
+- the staking, crisis and gov denoms against the denom minted by cudoMint
+- the module accounts against the names and permissions the app expects
+- the admin roles, i.e. the holders of ` + admintypes.AdminDenom + ` tokens
+
+Errors make the command fail, warnings are only reported.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.JSONCodec.(codec.Codec)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(config.GenesisFile())
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			if err := app.ModuleBasics.ValidateGenesis(cdc, clientCtx.TxConfig, appState); err != nil {
+				return fmt.Errorf("invalid genesis state: %w", err)
+			}
+
+			check, err := checkGenesis(cdc, appState)
+			if err != nil {
+				return err
+			}
+
+			check.print(cmd.OutOrStdout())
+			if len(check.Errors) > 0 {
+				return fmt.Errorf("genesis check found %d errors", len(check.Errors))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// genesisCheck collects the problems found in a genesis file.
+type genesisCheck struct {
+	Errors   []string
+	Warnings []string
+	Admins   []string
+}
+
+func (c *genesisCheck) errorf(format string, args ...interface{}) {
+	c.Errors = append(c.Errors, fmt.Sprintf(format, args...))
+}
+
+func (c *genesisCheck) warnf(format string, args ...interface{}) {
+	c.Warnings = append(c.Warnings, fmt.Sprintf(format, args...))
+}
+
+func checkGenesis(cdc codec.Codec, appState map[string]json.RawMessage) (genesisCheck, error) {
+	var check genesisCheck
+
+	// mint denom
+	var stakingGenState stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState); err != nil {
+		return check, fmt.Errorf("failed to unmarshal staking genesis state: %w", err)
+	}
+	if stakingGenState.Params.BondDenom != cudoMinttypes.MintDenom {
+		check.errorf("staking bond denom %s differs from the %s minted by %s", stakingGenState.Params.BondDenom, cudoMinttypes.MintDenom, cudoMinttypes.ModuleName)
+	}
+
+	var crisisGenState crisistypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[crisistypes.ModuleName], &crisisGenState); err != nil {
+		return check, fmt.Errorf("failed to unmarshal crisis genesis state: %w", err)
+	}
+	if crisisGenState.ConstantFee.Denom != cudoMinttypes.MintDenom {
+		check.warnf("crisis constant fee is paid in %s instead of %s", crisisGenState.ConstantFee.Denom, cudoMinttypes.MintDenom)
+	}
+
+	var govGenState govtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[govtypes.ModuleName], &govGenState); err != nil {
+		return check, fmt.Errorf("failed to unmarshal gov genesis state: %w", err)
+	}
+	for _, coin := range govGenState.DepositParams.MinDeposit {
+		if coin.Denom != cudoMinttypes.MintDenom {
+			check.warnf("gov min deposit is paid in %s instead of %s", coin.Denom, cudoMinttypes.MintDenom)
+		}
+	}
+
+	// module accounts
+	maccPerms := app.GetMaccPerms()
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return check, fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	accounts := make(map[string]authtypes.GenesisAccount)
+	for _, acc := range accs {
+		accounts[acc.GetAddress().String()] = acc
+
+		moduleName := moduleAccountName(acc.GetAddress())
+		moduleAcc, isModuleAccount := acc.(authtypes.ModuleAccountI)
+
+		switch {
+		case moduleName != "" && !isModuleAccount:
+			check.errorf("account %s at the address of module %s is not a module account", acc.GetAddress(), moduleName)
+		case moduleName != "" && !equalPermissions(moduleAcc.GetPermissions(), maccPerms[moduleName]):
+			check.errorf("module account %s has permissions %v, expected %v", moduleName, moduleAcc.GetPermissions(), maccPerms[moduleName])
+		case moduleName == "" && isModuleAccount:
+			check.warnf("module account %s is not used by the app", moduleAcc.GetName())
+		}
+	}
+
+	if minter, ok := accounts[authtypes.NewModuleAddress(cudoMinttypes.ModuleName).String()]; ok {
+		if moduleAcc, ok := minter.(authtypes.ModuleAccountI); ok && !moduleAcc.HasPermission(authtypes.Minter) {
+			check.errorf("%s module account cannot mint", cudoMinttypes.ModuleName)
+		}
+	}
+
+	// admin roles
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	for _, balance := range bankGenState.Balances {
+		if balance.Coins.AmountOf(admintypes.AdminDenom).IsZero() {
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(balance.Address)
+		if err != nil {
+			return check, err
+		}
+
+		check.Admins = append(check.Admins, balance.Address)
+		if moduleName := moduleAccountName(addr); moduleName != "" {
+			check.errorf("module account %s holds %s", moduleName, admintypes.AdminDenom)
+		} else if _, ok := accounts[balance.Address]; !ok {
+			check.warnf("admin %s has no account", balance.Address)
+		}
+	}
+	if len(check.Admins) == 0 {
+		check.warnf("no account holds %s, the admin messages cannot be used", admintypes.AdminDenom)
+	}
+
+	return check, nil
+}
+
+func (c genesisCheck) print(w io.Writer) {
+	fmt.Fprintf(w, "admins: %d\n", len(c.Admins))
+	for _, admin := range c.Admins {
+		fmt.Fprintf(w, "  %s\n", admin)
+	}
+	for _, warning := range c.Warnings {
+		fmt.Fprintf(w, "WARNING: %s\n", warning)
+	}
+	for _, err := range c.Errors {
+		fmt.Fprintf(w, "ERROR: %s\n", err)
+	}
+	if len(c.Errors) == 0 && len(c.Warnings) == 0 {
+		fmt.Fprintln(w, "genesis check passed")
+	}
+}
+
+// moduleAccountName returns the name of the module account of the app at the
+// address, or an empty string.
+func moduleAccountName(addr sdk.AccAddress) string {
+	for name := range app.GetMaccPerms() {
+		if authtypes.NewModuleAddress(name).Equals(addr) {
+			return name
+		}
+	}
+	return ""
+}
+
+func equalPermissions(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// editGenesis rewrites the app state of the genesis file.
+func editGenesis(genFile string, edit func(appState map[string]json.RawMessage) error) error {
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	if err := edit(appState); err != nil {
+		return err
+	}
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	genDoc.AppState = appStateJSON
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
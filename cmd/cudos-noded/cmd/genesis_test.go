@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CudoVentures/cudos-node/app"
+	admintypes "github.com/CudoVentures/cudos-node/x/admin/types"
+	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func genesisCudoMintState(t *testing.T, home string) cudoMinttypes.GenesisState {
+	var genState cudoMinttypes.GenesisState
+	app.MakeEncodingConfig().Codec.MustUnmarshalJSON(genesisAppState(t, home)[cudoMinttypes.ModuleName], &genState)
+	return genState
+}
+
+// useMintDenom makes the staking, crisis and gov genesis of home use the
+// denom minted by cudoMint.
+func useMintDenom(t *testing.T, home string) {
+	cdc := app.MakeEncodingConfig().Codec
+	require.NoError(t, editGenesis(filepath.Join(home, "config", "genesis.json"), func(appState map[string]json.RawMessage) error {
+		var stakingGenState stakingtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState)
+		stakingGenState.Params.BondDenom = cudoMinttypes.MintDenom
+		appState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
+
+		var crisisGenState crisistypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[crisistypes.ModuleName], &crisisGenState)
+		crisisGenState.ConstantFee.Denom = cudoMinttypes.MintDenom
+		appState[crisistypes.ModuleName] = cdc.MustMarshalJSON(&crisisGenState)
+
+		var govGenState govtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[govtypes.ModuleName], &govGenState)
+		govGenState.DepositParams.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin(cudoMinttypes.MintDenom, 1000))
+		appState[govtypes.ModuleName] = cdc.MustMarshalJSON(&govGenState)
+
+		return nil
+	}))
+}
+
+func TestGenesisCudoMintSetParams(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		args   []string
+		err    string
+		expect func(params *cudoMinttypes.Params)
+	}{
+		{
+			name:   "no flags",
+			expect: func(*cudoMinttypes.Params) {},
+		},
+		{
+			name:   "increment modifier",
+			args:   []string{"--increment-modifier", "14400"},
+			expect: func(params *cudoMinttypes.Params) { params.IncrementModifier = sdk.NewInt(14400) },
+		},
+		{
+			name: "staking feedback",
+			args: []string{"--goal-bonded", "0.5", "--emission-factor-min", "0.8", "--emission-factor-max", "1.2"},
+			expect: func(params *cudoMinttypes.Params) {
+				params.GoalBonded = sdk.MustNewDecFromStr("0.5")
+				params.EmissionFactorMin = sdk.MustNewDecFromStr("0.8")
+				params.EmissionFactorMax = sdk.MustNewDecFromStr("1.2")
+			},
+		},
+		{name: "invalid increment modifier", args: []string{"--increment-modifier", "many"}, err: "invalid increment modifier"},
+		{name: "zero increment modifier", args: []string{"--increment-modifier", "0"}, err: "invalid cudoMint genesis state"},
+		{name: "invalid goal bonded", args: []string{"--goal-bonded", "half"}, err: "invalid goal-bonded"},
+		{name: "goal bonded out of range", args: []string{"--goal-bonded", "1"}, err: "invalid cudoMint genesis state"},
+		{name: "max factor below one", args: []string{"--emission-factor-max", "0.9"}, err: "invalid cudoMint genesis state"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			home := setupGenesisHome(t)
+			before := genesisCudoMintState(t, home)
+
+			err := execGenesisCmd(home, GenesisCudoMintSetParamsCmd(home), tc.args...)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				require.Equal(t, before, genesisCudoMintState(t, home))
+				return
+			}
+			require.NoError(t, err)
+
+			expected := before
+			tc.expect(&expected.Params)
+			require.Equal(t, expected, genesisCudoMintState(t, home))
+		})
+	}
+}
+
+func TestGenesisCudoMintSetMinter(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		args   []string
+		err    string
+		expect func(minter *cudoMinttypes.Minter)
+	}{
+		{
+			name:   "norm time passed",
+			args:   []string{"--norm-time-passed", "0.6"},
+			expect: func(minter *cudoMinttypes.Minter) { minter.NormTimePassed = sdk.MustNewDecFromStr("0.6") },
+		},
+		{
+			name: "both fields",
+			args: []string{"--norm-time-passed", "2", "--mint-remainder", "0.25"},
+			expect: func(minter *cudoMinttypes.Minter) {
+				minter.NormTimePassed = sdk.NewDec(2)
+				minter.MintRemainder = sdk.MustNewDecFromStr("0.25")
+			},
+		},
+		{name: "invalid norm time passed", args: []string{"--norm-time-passed", "later"}, err: "invalid norm-time-passed"},
+		{name: "negative mint remainder", args: []string{"--mint-remainder", "-1"}, err: "invalid cudoMint genesis state"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			home := setupGenesisHome(t)
+			before := genesisCudoMintState(t, home)
+
+			err := execGenesisCmd(home, GenesisCudoMintSetMinterCmd(home), tc.args...)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				require.Equal(t, before, genesisCudoMintState(t, home))
+				return
+			}
+			require.NoError(t, err)
+
+			expected := before
+			tc.expect(&expected.Minter)
+			require.Equal(t, expected, genesisCudoMintState(t, home))
+		})
+	}
+}
+
+func TestGenesisAdminAddRole(t *testing.T) {
+	existing := sdk.AccAddress([]byte("existing____________"))
+	newAdmin := sdk.AccAddress([]byte("new_admin___________"))
+	moduleAddr := authtypes.NewModuleAddress(cudoMinttypes.ModuleName)
+
+	for _, tc := range []struct {
+		name     string
+		args     []string
+		err      string
+		accounts int
+		balance  sdk.Coins
+		supply   sdk.Coins
+	}{
+		{
+			name:     "existing account",
+			args:     []string{existing.String(), "--amount", "2"},
+			accounts: 1,
+			balance:  sdk.NewCoins(sdk.NewInt64Coin(cudoMinttypes.MintDenom, 100), sdk.NewInt64Coin(admintypes.AdminDenom, 2)),
+			supply:   sdk.NewCoins(sdk.NewInt64Coin(cudoMinttypes.MintDenom, 100), sdk.NewInt64Coin(admintypes.AdminDenom, 2)),
+		},
+		{
+			name:     "new account",
+			args:     []string{newAdmin.String()},
+			accounts: 2,
+			balance:  sdk.NewCoins(sdk.NewInt64Coin(admintypes.AdminDenom, 1)),
+			supply:   sdk.NewCoins(sdk.NewInt64Coin(cudoMinttypes.MintDenom, 100), sdk.NewInt64Coin(admintypes.AdminDenom, 1)),
+		},
+		{name: "module account", args: []string{moduleAddr.String()}, err: "cannot grant the admin role to module account cudoMint"},
+		{name: "zero amount", args: []string{newAdmin.String(), "--amount", "0"}, err: "must be a positive integer"},
+		{name: "unknown key", args: []string{"nobody", "--keyring-backend", "test"}, err: "failed to get address from Keybase"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			home := setupGenesisHome(t)
+			require.NoError(t, execGenesisCmd(home, AddGenesisAccountCmd(home), existing.String(), "100"+cudoMinttypes.MintDenom))
+			setGenesisSupply(t, home)
+
+			err := execGenesisCmd(home, GenesisAdminAddRoleCmd(home), tc.args...)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				require.Len(t, genesisAccounts(t, home), 1)
+				return
+			}
+			require.NoError(t, err)
+
+			addr, err := sdk.AccAddressFromBech32(tc.args[0])
+			require.NoError(t, err)
+			bankGenState := genesisBankState(t, home)
+			require.Len(t, genesisAccounts(t, home), tc.accounts)
+			require.Contains(t, bankGenState.Balances, banktypes.Balance{Address: addr.String(), Coins: tc.balance})
+			require.Equal(t, tc.supply, bankGenState.Supply)
+		})
+	}
+}
+
+func TestGenesisCheck(t *testing.T) {
+	admin := sdk.AccAddress([]byte("admin_______________"))
+
+	for _, tc := range []struct {
+		name   string
+		setup  func(t *testing.T, home string)
+		err    string
+		output []string
+	}{
+		{
+			name:   "default genesis",
+			setup:  func(*testing.T, string) {},
+			err:    "genesis check found 1 errors",
+			output: []string{"ERROR: staking bond denom stake differs from the acudos minted by cudoMint", "WARNING: no account holds cudosAdmin"},
+		},
+		{
+			name: "no admin",
+			setup: func(t *testing.T, home string) {
+				useMintDenom(t, home)
+			},
+			output: []string{"admins: 0", "WARNING: no account holds cudosAdmin"},
+		},
+		{
+			name: "admin",
+			setup: func(t *testing.T, home string) {
+				useMintDenom(t, home)
+				require.NoError(t, execGenesisCmd(home, GenesisAdminAddRoleCmd(home), admin.String()))
+			},
+			output: []string{"admins: 1\n  " + admin.String(), "genesis check passed"},
+		},
+		{
+			name: "module account holding the admin denom",
+			setup: func(t *testing.T, home string) {
+				useMintDenom(t, home)
+				moduleAddr := authtypes.NewModuleAddress(cudoMinttypes.ModuleName)
+				require.NoError(t, execGenesisCmd(home, AddGenesisAccountCmd(home), moduleAddr.String(), "1"+admintypes.AdminDenom))
+			},
+			err:    "genesis check found 2 errors",
+			output: []string{"is not a module account", "ERROR: module account cudoMint holds cudosAdmin"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			home := setupGenesisHome(t)
+			tc.setup(t, home)
+
+			cmd := GenesisCheckCmd(home)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+
+			err := execGenesisCmd(home, cmd)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, output := range tc.output {
+				require.Contains(t, out.String(), output)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/CudoVentures/cudos-node/app"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const flagGenesisTime = "genesis-time"
+
+// MigrateCudosGenesisCmd returns a command converting a genesis exported by
+// an older cudos-noded to the state of this version.
+func MigrateCudosGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-cudos [genesis-file]",
+		Short: "Migrate the Cudos module state of an exported genesis to this version",
+		Long: fmt.Sprintf(`Migrate the Cudos module state of a genesis exported by an older cudos-noded
+to the state of this version, the one after the %s upgrade, and print it to
+STDOUT. The conversions of every upgrade are applied, e.g. the cudoMint
+blocks_per_day param becomes increment_modifier and the modules introduced by
+the upgrades get their default genesis. The SDK modules are migrated with the
+migrate command.
+
+Example:
+$ cudos-noded migrate-cudos /path/to/genesis.json --chain-id=cudos-1 --genesis-time=2022-10-01T12:00:00Z
+`, app.Upgrades[len(app.Upgrades)-1].Name),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genDoc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var appState app.GenesisState
+			if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to JSON unmarshal initial genesis state: %w", err)
+			}
+
+			appState, err = app.MigrateGenesis(clientCtx.JSONCodec, appState)
+			if err != nil {
+				return err
+			}
+
+			if err := app.ModuleBasics.ValidateGenesis(clientCtx.JSONCodec, clientCtx.TxConfig, appState); err != nil {
+				return fmt.Errorf("invalid migrated genesis state: %w", err)
+			}
+
+			if genDoc.AppState, err = json.Marshal(appState); err != nil {
+				return fmt.Errorf("failed to JSON marshal migrated genesis state: %w", err)
+			}
+
+			genesisTime, _ := cmd.Flags().GetString(flagGenesisTime)
+			if genesisTime != "" {
+				var t time.Time
+				if err := t.UnmarshalText([]byte(genesisTime)); err != nil {
+					return fmt.Errorf("failed to unmarshal genesis time: %w", err)
+				}
+				genDoc.GenesisTime = t
+			}
+
+			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+			if chainID != "" {
+				genDoc.ChainID = chainID
+			}
+
+			bz, err := tmjson.Marshal(genDoc)
+			if err != nil {
+				return fmt.Errorf("failed to marshal genesis doc: %w", err)
+			}
+
+			sortedBz, err := sdk.SortJSON(bz)
+			if err != nil {
+				return fmt.Errorf("failed to sort JSON genesis doc: %w", err)
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(sortedBz))
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagGenesisTime, "", "override genesis_time with this flag")
+	cmd.Flags().String(flags.FlagChainID, "", "override chain_id with this flag")
+
+	return cmd
+}
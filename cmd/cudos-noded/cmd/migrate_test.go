@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/CudoVentures/cudos-node/app"
+	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMigrateCudosGenesis(t *testing.T) {
+	home := setupGenesisHome(t)
+	genFile := filepath.Join(home, "config", "genesis.json")
+
+	// a v1.0 export: legacy cudoMint params and none of the later modules
+	require.NoError(t, editGenesis(genFile, func(appState map[string]json.RawMessage) error {
+		appState[cudoMinttypes.ModuleName] = json.RawMessage(`{
+			"minter": {"mint_remainder": "0.000000000000000000", "norm_time_passed": "0.600000000000000000"},
+			"params": {"blocks_per_day": "14400"}
+		}`)
+		for _, upgrade := range app.Upgrades[1:] {
+			for _, moduleName := range upgrade.Modules() {
+				delete(appState, moduleName)
+			}
+		}
+		return nil
+	}))
+
+	cmd := MigrateCudosGenesisCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	require.NoError(t, execGenesisCmd(home, cmd, genFile, "--chain-id", "cudos-2"))
+
+	genDoc, err := tmtypes.GenesisDocFromJSON(out.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, "cudos-2", genDoc.ChainID)
+
+	// the output decodes with the types of this version
+	var appState app.GenesisState
+	require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+	encCfg := app.MakeEncodingConfig()
+	for moduleName := range app.ModuleBasics {
+		require.Contains(t, appState, moduleName)
+	}
+	require.NoError(t, app.ModuleBasics.ValidateGenesis(encCfg.Codec, encCfg.TxConfig, appState))
+
+	var cudoMintGenState cudoMinttypes.GenesisState
+	encCfg.Codec.MustUnmarshalJSON(appState[cudoMinttypes.ModuleName], &cudoMintGenState)
+	require.Equal(t, sdk.NewInt(14400), cudoMintGenState.Params.IncrementModifier)
+	require.Equal(t, cudoMinttypes.DefaultParams().GoalBonded, cudoMintGenState.Params.GoalBonded)
+
+	// only the genesis file is taken
+	require.Error(t, execGenesisCmd(home, MigrateCudosGenesisCmd(), "v1.1", genFile))
+}
@@ -81,16 +81,23 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		genutilcli.InitCmd(app.ModuleBasics, app.DefaultNodeHome),
 		gravitycmd.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.MigrateGenesisCmd(),
+		MigrateCudosGenesisCmd(),
 		gravitycmd.GenTxCmd(app.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
+		AddGenesisAccountsBulkCmd(app.DefaultNodeHome),
+		GenesisCmd(app.DefaultNodeHome),
+		CudoMintCmd(),
 		AddGenesisWasmMsgCmd(app.DefaultNodeHome),
+		UpgradeCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(),
 	)
 
 	a := appCreator{encodingConfig}
 	server.AddCommands(rootCmd, app.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
+	addExportFlags(rootCmd)
+	rootCmd.AddCommand(InPlaceTestnetCmd(a, app.DefaultNodeHome))
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
@@ -101,6 +108,24 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	)
 }
 
+const flagTestnetFork = "testnet-fork"
+
+func addExportFlags(rootCmd *cobra.Command) {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() != "export" {
+			continue
+		}
+
+		cmd.Flags().String(flagTestnetFork, "", `Export the genesis of a testnet forked from this chain as described by a JSON file:
+{
+  "validators": [{"operator_address": "cudosvaloper1... (optional)", "consensus_pubkey": {"@type": "/cosmos.crypto.ed25519.PubKey", "key": "..."}}],
+  "wasm": {"mode": "keep|shrink|drop", "max_state_entries": 100},
+  "balance_remaps": [{"from": "cudos1...", "to": "cudos1..."}],
+  "norm_time_passed": "0.5"
+}`)
+	}
+}
+
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 	wasm.AddModuleInitFlags(startCmd)
@@ -231,6 +256,14 @@ func (a appCreator) appExport(
 		anApp = app.New(logger, db, traceStore, true, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts)
 	}
 
+	if forkFile, _ := appOpts.Get(flagTestnetFork).(string); forkFile != "" {
+		fork, err := app.LoadTestnetFork(forkFile)
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		return anApp.ExportTestnetFork(forZeroHeight, jailAllowedAddrs, fork)
+	}
+
 	return anApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 }
 
@@ -0,0 +1,245 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/privval"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CudoVentures/cudos-node/app"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagSelfDelegation = "self-delegation"
+	flagDevFunds       = "dev-funds"
+	flagVotingPeriod   = "voting-period"
+	flagEthAddress     = "eth-address"
+
+	// keys under which the in-place testnet command hands its options and
+	// the node's Tendermint config to the app creator through the app options
+	appOptInPlaceTestnet = "in-place-testnet"
+	appOptTendermintCfg  = "in-place-testnet-tendermint-config"
+)
+
+// InPlaceTestnetCmd returns a command that turns the state of a node into a
+// single validator network and starts it.
+func InPlaceTestnetCmd(a appCreator, defaultNodeHome string) *cobra.Command {
+	cmd := server.StartCmd(func(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+		testnet, ok := appOpts.Get(appOptInPlaceTestnet).(app.InPlaceTestnet)
+		if !ok {
+			panic("the in-place testnet options are missing from the app options")
+		}
+		config, ok := appOpts.Get(appOptTendermintCfg).(*tmcfg.Config)
+		if !ok {
+			panic("the Tendermint config is missing from the app options")
+		}
+
+		cudosApp := a.newApp(logger, db, traceStore, appOpts).(*app.App)
+		if err := prepareInPlaceTestnet(config, cudosApp, testnet); err != nil {
+			panic(fmt.Errorf("failed to prepare the in-place testnet: %w", err))
+		}
+		return cudosApp
+	}, defaultNodeHome)
+
+	cmd.Use = "in-place-testnet [chain-id] [validator-key]"
+	cmd.Short = "Turn the state of this node into a single validator testnet and start it"
+	cmd.Long = `Rewrite the node's state so that it starts as a local network with a new
+chain-id and a single validator, then start the node like the start command.
+
+The validator signs with the node's priv_validator_key.json and is operated by
+validator-key, a key name or address, which is funded with --self-delegation
+and --dev-funds. All other validators are jailed.
+
+Only run this on a copy of the data directory of a stopped node: the Tendermint
+state is rewritten in place and the node can no longer join its original
+network. The app state changes are committed with the first block, later runs
+can use the start command. Upgrade handlers can then be exercised against real
+state by passing an upgrade proposal with a short --voting-period.`
+	cmd.Args = cobra.ExactArgs(2)
+
+	startRunE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx := client.GetClientContextFromCmd(cmd)
+		serverCtx := server.GetServerContextFromCmd(cmd)
+
+		operator, err := newAddressResolver(cmd, clientCtx).resolve(args[1])
+		if err != nil {
+			return err
+		}
+
+		selfDelegationStr, _ := cmd.Flags().GetString(flagSelfDelegation)
+		selfDelegation, err := sdk.ParseCoinNormalized(selfDelegationStr)
+		if err != nil {
+			return fmt.Errorf("failed to parse self delegation: %w", err)
+		}
+
+		devFundsStr, _ := cmd.Flags().GetString(flagDevFunds)
+		devFunds, err := sdk.ParseCoinsNormalized(devFundsStr)
+		if err != nil {
+			return fmt.Errorf("failed to parse dev funds: %w", err)
+		}
+
+		votingPeriod, err := cmd.Flags().GetDuration(flagVotingPeriod)
+		if err != nil {
+			return err
+		}
+
+		ethAddress, _ := cmd.Flags().GetString(flagEthAddress)
+
+		serverCtx.Viper.Set(appOptInPlaceTestnet, app.InPlaceTestnet{
+			ChainID:        args[0],
+			Operator:       operator,
+			SelfDelegation: selfDelegation,
+			DevFunds:       devFunds,
+			EthAddress:     ethAddress,
+			VotingPeriod:   votingPeriod,
+		})
+		serverCtx.Viper.Set(appOptTendermintCfg, serverCtx.Config)
+
+		// the node must not dial the peers of the original network
+		serverCtx.Config.P2P.Seeds = ""
+		serverCtx.Config.P2P.PersistentPeers = ""
+		if err := os.Remove(serverCtx.Config.P2P.AddrBookFile()); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		return startRunE(cmd, args)
+	}
+
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagSelfDelegation, "10000000000000000000000000acudos", "Self delegation of the testnet validator")
+	cmd.Flags().String(flagDevFunds, "1000000000000000000000000000acudos", "Coins the validator operator receives on top of the self delegation")
+	cmd.Flags().String(flagEthAddress, "", "Gravity bridge Ethereum address of the validator, derived from the operator address when empty")
+	cmd.Flags().Duration(flagVotingPeriod, 0, "Gov voting period of the testnet, unchanged when zero")
+	addModuleInitFlags(cmd)
+
+	return cmd
+}
+
+// prepareInPlaceTestnet converts the app state and rewrites the Tendermint
+// state so that the node's validator key alone signs the next block.
+func prepareInPlaceTestnet(config *tmcfg.Config, cudosApp *app.App, testnet app.InPlaceTestnet) error {
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB)
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if state.IsEmpty() {
+		return fmt.Errorf("the node has no state yet")
+	}
+
+	height := state.LastBlockHeight
+	if blockStore.Height() != height || cudosApp.LastBlockHeight() != height {
+		return fmt.Errorf("the block store (%d), the state (%d) and the app (%d) are at different heights, start and stop the node once before",
+			blockStore.Height(), height, cudosApp.LastBlockHeight())
+	}
+
+	pv := privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	tmPubKey, err := pv.GetPubKey()
+	if err != nil {
+		return err
+	}
+	testnet.ConsensusPubKey, err = cryptocodec.FromTmPubKeyInterface(tmPubKey)
+	if err != nil {
+		return err
+	}
+	testnet.BlockTime = state.LastBlockTime
+
+	power, err := cudosApp.ConvertToInPlaceTestnet(testnet)
+	if err != nil {
+		return err
+	}
+
+	// the last block is committed by the new validator alone
+	seenCommit := blockStore.LoadSeenCommit(height)
+	vote := &tmproto.Vote{
+		Type:             tmproto.PrecommitType,
+		Height:           height,
+		Round:            seenCommit.Round,
+		BlockID:          state.LastBlockID.ToProto(),
+		Timestamp:        time.Now().UTC(),
+		ValidatorAddress: tmPubKey.Address(),
+		ValidatorIndex:   0,
+	}
+	if err := pv.SignVote(testnet.ChainID, vote); err != nil {
+		return err
+	}
+	seenCommit.BlockID = state.LastBlockID
+	seenCommit.Signatures = []tmtypes.CommitSig{tmtypes.NewCommitSigForBlock(vote.Signature, tmPubKey.Address(), vote.Timestamp)}
+	if err := blockStore.SaveSeenCommit(height, seenCommit); err != nil {
+		return err
+	}
+
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(tmPubKey, power)})
+	state.ChainID = testnet.ChainID
+	state.LastValidators = valSet
+	state.Validators = valSet.Copy()
+	state.NextValidators = valSet.Copy()
+	state.LastHeightValidatorsChanged = height + 1
+	if err := stateStore.Save(state); err != nil {
+		return err
+	}
+
+	valSetProto, err := valSet.ToProto()
+	if err != nil {
+		return err
+	}
+	for _, h := range []int64{height, height + 1} {
+		valInfo := tmstate.ValidatorsInfo{ValidatorSet: valSetProto, LastHeightChanged: h}
+		bz, err := valInfo.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := stateDB.Set([]byte(fmt.Sprintf("validatorsKey:%v", h)), bz); err != nil {
+			return err
+		}
+	}
+
+	genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+	genDoc.ChainID = testnet.ChainID
+	if err := genDoc.SaveAs(config.GenesisFile()); err != nil {
+		return err
+	}
+
+	// the node prefers the genesis stored with its state over the file
+	bz, err := tmjson.Marshal(genDoc)
+	if err != nil {
+		return err
+	}
+	return stateDB.SetSync([]byte("genesisDoc"), bz)
+}
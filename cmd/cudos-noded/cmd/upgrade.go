@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/CudoVentures/cudos-node/app"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeCmd returns the tools for preparing coordinated upgrades.
+func UpgradeCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Tools for preparing software upgrades",
+	}
+
+	cmd.AddCommand(SimulateUpgradeCmd(defaultNodeHome))
+
+	return cmd
+}
+
+// SimulateUpgradeCmd returns a command that dry-runs a registered upgrade
+// against the local node database.
+func SimulateUpgradeCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [plan-name]",
+		Short: "Dry-run an upgrade against the local state",
+		Long: `Load the node's database at the latest height, apply the store changes and
+the handler of the named upgrade in a cache-wrapped context and report the
+migrated module versions, the invariants and the changed keys per store.
+
+Nothing is committed. Stop the node before running the simulation.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(cmd.Flag(flags.FlagHome).Value.String())
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			cudosApp := app.New(
+				serverCtx.Logger, db, nil, false, map[int64]bool{}, config.RootDir, 0,
+				app.MakeEncodingConfig(), serverCtx.Viper,
+			)
+
+			simulation, err := cudosApp.SimulateUpgrade(args[0])
+			if err != nil {
+				return err
+			}
+
+			if output, _ := cmd.Flags().GetString(tmcli.OutputFlag); output == "json" {
+				bz, err := json.MarshalIndent(simulation, "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return err
+			}
+
+			printUpgradeSimulation(cmd.OutOrStdout(), simulation)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+func printUpgradeSimulation(w io.Writer, simulation app.UpgradeSimulation) {
+	fmt.Fprintf(w, "upgrade %s simulated at height %d\n", simulation.Plan, simulation.Height)
+
+	fmt.Fprintln(w, "\nstore changes:")
+	for _, store := range simulation.StoreUpgrades.Added {
+		fmt.Fprintf(w, "  added   %s\n", store)
+	}
+	for _, rename := range simulation.StoreUpgrades.Renamed {
+		fmt.Fprintf(w, "  renamed %s -> %s\n", rename.OldKey, rename.NewKey)
+	}
+	for _, store := range simulation.StoreUpgrades.Deleted {
+		fmt.Fprintf(w, "  deleted %s\n", store)
+	}
+
+	fmt.Fprintln(w, "\nmodule versions:")
+	for _, m := range simulation.Modules {
+		marker := ""
+		if m.FromVersion != m.ToVersion {
+			marker = " *"
+		}
+		fmt.Fprintf(w, "  %-14s %d -> %d%s\n", m.Module, m.FromVersion, m.ToVersion, marker)
+	}
+
+	broken := 0
+	fmt.Fprintln(w, "\ninvariants:")
+	for _, invariant := range simulation.Invariants {
+		if invariant.Broken {
+			broken++
+			fmt.Fprintf(w, "  BROKEN %s: %s\n", invariant.Route, invariant.Message)
+		}
+	}
+	fmt.Fprintf(w, "  %d checked, %d broken\n", len(simulation.Invariants), broken)
+
+	fmt.Fprintln(w, "\nstate diff:")
+	for _, diff := range simulation.StoreDiffs {
+		fmt.Fprintf(w, "  %-14s +%d ~%d -%d\n", diff.Store, diff.Added, diff.Updated, diff.Deleted)
+	}
+}
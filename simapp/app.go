@@ -40,6 +40,7 @@ import (
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 
 	// Authz - Authorization for accounts to perform actions on behalf of other accounts.
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -146,6 +147,7 @@ var (
 	// and genesis verification.
 	ModuleBasics = module.NewBasicManager(
 		auth.AppModuleBasic{},
+		vesting.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		genutil.AppModuleBasic{},
 		bank.AppModuleBasic{},
@@ -182,6 +184,7 @@ var (
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		cudoMinttypes.ModuleName:       {authtypes.Minter},
 		gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+		admintypes.ModuleName:          nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -297,6 +300,7 @@ func NewSimApp(
 		wasm.StoreKey,
 		gravitytypes.StoreKey,
 		feegrant.StoreKey,
+		admintypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -414,7 +418,7 @@ func NewSimApp(
 
 	app.adminKeeper = *adminkeeper.NewKeeper(
 		appCodec, keys[admintypes.StoreKey], keys[admintypes.MemStoreKey],
-		app.DistrKeeper, app.BankKeeper,
+		app.DistrKeeper, app.BankKeeper, nil,
 	)
 
 	app.GovKeeper = govkeeper.NewKeeper(
@@ -450,8 +454,11 @@ func NewSimApp(
 		keys[cudoMinttypes.MemStoreKey],
 		app.BankKeeper,
 		app.AccountKeeper,
+		app.DistrKeeper,
+		app.StakingKeeper,
 		app.GetSubspace(cudoMinttypes.ModuleName),
 		authtypes.FeeCollectorName,
+		app.ModuleAccountAddrs(),
 	)
 	cudoMintModule := cudoMint.NewAppModule(appCodec, app.CudoMintKeeper)
 
@@ -493,7 +500,7 @@ func NewSimApp(
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
 		wasm.NewAppModule(appCodec, &app.wasmKeeper, app.StakingKeeper),
-		admin.NewAppModule(appCodec, app.adminKeeper),
+		admin.NewAppModule(appCodec, app.adminKeeper, app.AccountKeeper, app.BankKeeper, app.DistrKeeper),
 		cudoMintModule,
 		gravityModule,
 		feegrantModule,
@@ -606,7 +613,8 @@ func NewSimApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
-		// cudoMintModule, // needs interface
+		cudoMintModule,
+		admin.NewAppModule(appCodec, app.adminKeeper, app.AccountKeeper, app.BankKeeper, app.DistrKeeper),
 		gravityModule,
 		feegrantModule,
 	)
@@ -648,9 +656,13 @@ func NewSimApp(
 		// This must be done during creation of baseapp rather than in InitChain so
 		// that in-memory capabilities get regenerated on app restart.
 		// Note that since this reads from the store, we can only perform it when
-		// `loadLatest` is set to true.
-		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
-		app.CapabilityKeeper.InitMemStore(ctx)
+		// `loadLatest` is set to true. A new chain has nothing persisted yet, it
+		// loads the capabilities of its genesis in InitGenesis instead, which
+		// would be skipped once the memory store is marked as initialized.
+		if app.LastBlockHeight() > 0 {
+			ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+			app.CapabilityKeeper.InitMemStore(ctx)
+		}
 		app.CapabilityKeeper.Seal()
 	}
 
@@ -1,6 +1,7 @@
 package simapp
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,6 +12,7 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdksimapp "github.com/cosmos/cosmos-sdk/simapp"
@@ -126,9 +128,29 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 			})
 		}
 
+		// gravity builds its valsets from the static validators with an eth key
+		gravityState := new(gravitytypes.GenesisState)
+		cdc.MustUnmarshalJSON(rawState[gravitytypes.ModuleName], gravityState)
+		if len(gravityState.StaticValCosmosAddrs) == 0 {
+			for _, val := range stakingState.Validators {
+				valAddr, err := sdk.ValAddressFromBech32(val.OperatorAddress)
+				if err != nil {
+					panic(err)
+				}
+				accAddr := sdk.AccAddress(valAddr).String()
+				gravityState.StaticValCosmosAddrs = append(gravityState.StaticValCosmosAddrs, accAddr)
+				gravityState.DelegateKeys = append(gravityState.DelegateKeys, &gravitytypes.MsgSetOrchestratorAddress{
+					Validator:    val.OperatorAddress,
+					Orchestrator: accAddr,
+					EthAddress:   "0x" + hex.EncodeToString(valAddr),
+				})
+			}
+		}
+
 		// change appState back
 		rawState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingState)
 		rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+		rawState[gravitytypes.ModuleName] = cdc.MustMarshalJSON(gravityState)
 
 		// replace appstate
 		appState, err = json.Marshal(rawState)
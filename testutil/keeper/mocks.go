@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// AccountKeeper resolves module accounts without an account store.
+type AccountKeeper struct{}
+
+func (AccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (AccountKeeper) GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(name)
+}
+
+// BankKeeper keeps the balances in memory, the balance of a module is the one
+// of its module address. It also funds the community pool in place of the
+// distribution keeper.
+type BankKeeper struct {
+	Balances      map[string]sdk.Coins
+	Blocked       map[string]bool
+	CommunityPool sdk.Coins
+}
+
+func NewBankKeeper() *BankKeeper {
+	return &BankKeeper{Balances: map[string]sdk.Coins{}, Blocked: map[string]bool{}}
+}
+
+func (bk *BankKeeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, bk.Balances[addr.String()].AmountOf(denom))
+}
+
+func (bk *BankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.Balances[addr.String()]
+}
+
+func (bk *BankKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.Balances[addr.String()]
+}
+
+func (bk *BankKeeper) SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := bk.Balances[from.String()].SafeSub(amt)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	bk.Balances[from.String()] = balance
+	bk.Balances[to.String()] = bk.Balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (bk *BankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return bk.SendCoins(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (bk *BankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return bk.SendCoins(ctx, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (bk *BankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if bk.Blocked[recipientAddr.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
+	}
+	return bk.SendCoins(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (bk *BankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return bk.Blocked[addr.String()]
+}
+
+func (bk *BankKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if err := bk.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount); err != nil {
+		return err
+	}
+	bk.CommunityPool = bk.CommunityPool.Add(amount...)
+	return nil
+}
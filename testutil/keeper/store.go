@@ -0,0 +1,77 @@
+// Package keeper provides the store fixture and the in-memory keepers shared
+// by the keeper tests of the modules.
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Stores collects the stores of the keepers under test and mounts them on an
+// in-memory multistore.
+type Stores struct {
+	Codec codec.Codec
+
+	keys       []storetypes.StoreKey
+	paramsKey  *sdk.KVStoreKey
+	paramsTKey *sdk.TransientStoreKey
+}
+
+// NewStores returns a fixture without stores and with an empty proto codec.
+func NewStores() *Stores {
+	return &Stores{Codec: codec.NewProtoCodec(codectypes.NewInterfaceRegistry())}
+}
+
+// KVStoreKey returns the key of a new store named name.
+func (s *Stores) KVStoreKey(name string) *sdk.KVStoreKey {
+	key := sdk.NewKVStoreKey(name)
+	s.keys = append(s.keys, key)
+	return key
+}
+
+// Subspace returns the param subspace of a module, all the subspaces share
+// one params store.
+func (s *Stores) Subspace(moduleName string) paramtypes.Subspace {
+	if s.paramsKey == nil {
+		s.paramsKey = s.KVStoreKey(paramtypes.StoreKey)
+		s.paramsTKey = sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+		s.keys = append(s.keys, s.paramsTKey)
+	}
+	return paramtypes.NewSubspace(s.Codec, codec.NewLegacyAmino(), s.paramsKey, s.paramsTKey, moduleName)
+}
+
+// Context mounts the stores and returns a deliver context at header.
+func (s *Stores) Context(t testing.TB, header tmproto.Header) sdk.Context {
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	for _, key := range s.keys {
+		storeType := storetypes.StoreTypeIAVL
+		if _, ok := key.(*sdk.TransientStoreKey); ok {
+			storeType = storetypes.StoreTypeTransient
+		}
+		stateStore.MountStoreWithDB(key, storeType, db)
+	}
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	return sdk.NewContext(stateStore, header, false, log.NewNopLogger())
+}
+
+// RequireInvariants fails the test if one of the invariants is broken.
+func RequireInvariants(t testing.TB, ctx sdk.Context, invariants ...sdk.Invariant) {
+	t.Helper()
+	for _, invariant := range invariants {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+}
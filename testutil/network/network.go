@@ -6,15 +6,16 @@ import (
 	"time"
 
 	"github.com/CudoVentures/cudos-node/app"
+	"github.com/CudoVentures/cudos-node/simapp"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
-	"github.com/cosmos/cosmos-sdk/simapp"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	tmdb "github.com/tendermint/tm-db"
 )
@@ -24,6 +25,10 @@ type (
 	Config  = network.Config
 )
 
+// powerReduction is the amount of acudos per unit of consensus power, as set
+// by the main of cudos-noded.
+var powerReduction = sdk.NewIntFromUint64(1000000000000000000)
+
 // New creates instance with fully configured cosmos network.
 // Accepts optional config, that will be used in place of the DefaultConfig() if provided.
 func New(t *testing.T, configs ...network.Config) *network.Network {
@@ -36,6 +41,13 @@ func New(t *testing.T, configs ...network.Config) *network.Network {
 	} else {
 		cfg = configs[0]
 	}
+
+	// the staking keeper reads the power reduction from the sdk default, use
+	// the one of the chain while the network runs
+	defaultPowerReduction := sdk.DefaultPowerReduction
+	sdk.DefaultPowerReduction = powerReduction
+	t.Cleanup(func() { sdk.DefaultPowerReduction = defaultPowerReduction })
+
 	net := network.New(t, cfg)
 	t.Cleanup(net.Cleanup)
 	return net
@@ -45,6 +57,7 @@ func New(t *testing.T, configs ...network.Config) *network.Network {
 // genesis and single validator. All other parameters are inherited from cosmos-sdk/testutil/network.DefaultConfig
 func DefaultConfig() network.Config {
 	encoding := app.MakeEncodingConfig()
+	minSelfDelegation, _ := sdk.NewIntFromString(stakingtypes.MinSelfDelegation)
 	return network.Config{
 		Codec:             encoding.Codec,
 		TxConfig:          encoding.TxConfig,
@@ -60,18 +73,20 @@ func DefaultConfig() network.Config {
 				baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
 			)
 		},
-		GenesisState:    app.ModuleBasics.DefaultGenesis(encoding.Codec),
-		TimeoutCommit:   2 * time.Second,
-		ChainID:         "chain-" + tmrand.NewRand().Str(6),
-		NumValidators:   1,
-		BondDenom:       sdk.DefaultBondDenom,
-		MinGasPrices:    fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom),
-		AccountTokens:   sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction),
-		StakingTokens:   sdk.TokensFromConsensusPower(500, sdk.DefaultPowerReduction),
-		BondedTokens:    sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction),
-		PruningStrategy: storetypes.PruningOptionNothing,
-		CleanupDir:      true,
-		SigningAlgo:     string(hd.Secp256k1Type),
-		KeyringOptions:  []keyring.Option{},
+		GenesisState:      app.ModuleBasics.DefaultGenesis(encoding.Codec),
+		TimeoutCommit:     2 * time.Second,
+		ChainID:           "chain-" + tmrand.NewRand().Str(6),
+		NumValidators:     1,
+		BondDenom:         sdk.DefaultBondDenom,
+		MinGasPrices:      fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom),
+		AccountTokens:     sdk.TokensFromConsensusPower(20000000, powerReduction),
+		StakingTokens:     sdk.TokensFromConsensusPower(10000000, powerReduction),
+		BondedTokens:      sdk.TokensFromConsensusPower(2000000, powerReduction),
+		MinSelfDelegation: minSelfDelegation,
+		PruningStrategy:   storetypes.PruningOptionNothing,
+		CleanupDir:        true,
+		SigningAlgo:       string(hd.Secp256k1Type),
+		KeyringOptions:    []keyring.Option{},
+		PatchGenesis:      simapp.PatchGravityBridgeGenesis,
 	}
 }
@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/CudoVentures/cudos-node/x/addressbook/types"
+)
+
+const (
+	FlagNetwork = "network"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	// Group addressbook queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryAddress(),
+		CmdQueryAddresses(),
+		CmdQueryAllAddresses(),
+		CmdQueryLookup(),
+	)
+
+	return cmd
+}
+
+func CmdQueryAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address [owner] [network] [label]",
+		Short: "Query an address of an owner",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Address(context.Background(), &types.QueryAddressRequest{
+				Owner:   args[0],
+				Network: args[1],
+				Label:   args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addresses [owner]",
+		Short: "Query the addresses of an owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			network, err := cmd.Flags().GetString(FlagNetwork)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Addresses(context.Background(), &types.QueryAddressesRequest{
+				Owner:      args[0],
+				Network:    network,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagNetwork, "", "Only query the addresses of this network")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "addresses")
+
+	return cmd
+}
+
+func CmdQueryAllAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-addresses",
+		Short: "Query all registered addresses",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllAddresses(context.Background(), &types.QueryAllAddressesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-addresses")
+
+	return cmd
+}
+
+func CmdQueryLookup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lookup [network] [value]",
+		Short: "Query the addresses registered with a value in a network",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Lookup(context.Background(), &types.QueryLookupRequest{
+				Network:    args[0],
+				Value:      args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "lookup")
+
+	return cmd
+}
@@ -0,0 +1,26 @@
+package addressbook
+
+import (
+	"github.com/CudoVentures/cudos-node/x/addressbook/keeper"
+	"github.com/CudoVentures/cudos-node/x/addressbook/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the addressbook module's state from a provided
+// genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for _, address := range data.Addresses {
+		k.SetAddress(ctx, address)
+	}
+}
+
+// ExportGenesis returns the addressbook module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	addresses := []types.Address{}
+	k.IterateAddresses(ctx, func(address types.Address) bool {
+		addresses = append(addresses, address)
+		return false
+	})
+
+	return types.NewGenesisState(addresses)
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"github.com/CudoVentures/cudos-node/x/addressbook/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateAddress registers an address under a label the owner does not use
+// yet in the network.
+func (k Keeper) CreateAddress(ctx sdk.Context, owner sdk.AccAddress, network, label, value string) error {
+	if k.HasAddress(ctx, owner, network, label) {
+		return sdkerrors.Wrapf(types.ErrAddressExists, "%s already has a %s address labelled %s", owner, network, label)
+	}
+
+	k.SetAddress(ctx, types.Address{Owner: owner.String(), Network: network, Label: label, Value: value})
+	return nil
+}
+
+// UpdateAddress changes the value of a registered address.
+func (k Keeper) UpdateAddress(ctx sdk.Context, owner sdk.AccAddress, network, label, value string) error {
+	address, err := k.GetAddress(ctx, owner, network, label)
+	if err != nil {
+		return err
+	}
+
+	k.deleteAddress(ctx, address)
+	address.Value = value
+	k.SetAddress(ctx, address)
+	return nil
+}
+
+// DeleteAddress removes a registered address.
+func (k Keeper) DeleteAddress(ctx sdk.Context, owner sdk.AccAddress, network, label string) (types.Address, error) {
+	address, err := k.GetAddress(ctx, owner, network, label)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	k.deleteAddress(ctx, address)
+	return address, nil
+}
+
+// HasAddress returns whether the owner has an address with the label in the
+// network.
+func (k Keeper) HasAddress(ctx sdk.Context, owner sdk.AccAddress, network, label string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.AddressKey(owner, network, label))
+}
+
+// GetAddress returns the address of the owner with the label in the network.
+func (k Keeper) GetAddress(ctx sdk.Context, owner sdk.AccAddress, network, label string) (types.Address, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AddressKey(owner, network, label))
+	if bz == nil {
+		return types.Address{}, sdkerrors.Wrapf(types.ErrUnknownAddress, "%s has no %s address labelled %s", owner, network, label)
+	}
+
+	var address types.Address
+	k.cdc.MustUnmarshal(bz, &address)
+	return address, nil
+}
+
+// SetAddress stores an address and its lookup index.
+func (k Keeper) SetAddress(ctx sdk.Context, address types.Address) {
+	owner, err := sdk.AccAddressFromBech32(address.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AddressKey(owner, address.Network, address.Label), k.cdc.MustMarshal(&address))
+	store.Set(types.LookupKey(owner, address.Network, address.Label, address.Value), []byte{0x01})
+}
+
+func (k Keeper) deleteAddress(ctx sdk.Context, address types.Address) {
+	owner, err := sdk.AccAddressFromBech32(address.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AddressKey(owner, address.Network, address.Label))
+	store.Delete(types.LookupKey(owner, address.Network, address.Label, address.Value))
+}
+
+// IterateAddresses iterates over the addresses ordered by owner, network and
+// label until cb returns true.
+func (k Keeper) IterateAddresses(ctx sdk.Context, cb func(address types.Address) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AddressKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var address types.Address
+		k.cdc.MustUnmarshal(iterator.Value(), &address)
+		if cb(address) {
+			break
+		}
+	}
+}
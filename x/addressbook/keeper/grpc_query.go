@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/CudoVentures/cudos-node/x/addressbook/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Address(goCtx context.Context, req *types.QueryAddressRequest) (*types.QueryAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", req.Owner)
+	}
+
+	address, err := k.GetAddress(ctx, owner, req.Network, req.Label)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryAddressResponse{Address: address}, nil
+}
+
+func (k Keeper) Addresses(goCtx context.Context, req *types.QueryAddressesRequest) (*types.QueryAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", req.Owner)
+	}
+
+	addressesPrefix := types.OwnerAddressesKey(owner)
+	if req.Network != "" {
+		addressesPrefix = types.NetworkAddressesKey(owner, req.Network)
+	}
+
+	return k.paginateAddresses(ctx, addressesPrefix, req.Pagination)
+}
+
+func (k Keeper) AllAddresses(goCtx context.Context, req *types.QueryAllAddressesRequest) (*types.QueryAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return k.paginateAddresses(ctx, types.AddressKeyPrefix, req.Pagination)
+}
+
+func (k Keeper) Lookup(goCtx context.Context, req *types.QueryLookupRequest) (*types.QueryAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addresses := []types.Address{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LookupAddressesKey(req.Network, req.Value))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		owner, label := types.SplitLookupKey(key)
+		address, err := k.GetAddress(ctx, owner, req.Network, label)
+		if err != nil {
+			return err
+		}
+		addresses = append(addresses, address)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// paginateAddresses returns the addresses stored under a prefix.
+func (k Keeper) paginateAddresses(ctx sdk.Context, addressesPrefix []byte, pageReq *query.PageRequest) (*types.QueryAddressesResponse, error) {
+	addresses := []types.Address{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), addressesPrefix)
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var address types.Address
+		if err := k.cdc.Unmarshal(value, &address); err != nil {
+			return err
+		}
+		addresses = append(addresses, address)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/CudoVentures/cudos-node/x/addressbook/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	Keeper struct {
+		cdc      codec.Codec
+		storeKey sdk.StoreKey
+	}
+)
+
+func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey) *Keeper {
+	return &Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
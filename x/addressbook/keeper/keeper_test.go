@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/addressbook"
+	"github.com/CudoVentures/cudos-node/x/addressbook/keeper"
+	"github.com/CudoVentures/cudos-node/x/addressbook/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	alice = sdk.AccAddress([]byte("alice_______________"))
+	bob   = sdk.AccAddress([]byte("bob_________________"))
+)
+
+const (
+	ethAddress1 = "0x0000000000000000000000000000000000000001"
+	ethAddress2 = "0x0000000000000000000000000000000000000002"
+)
+
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	stores := keepertest.NewStores()
+	k := keeper.NewKeeper(stores.Codec, stores.KVStoreKey(types.StoreKey))
+	return *k, stores.Context(t, tmproto.Header{})
+}
+
+func TestCreateUpdateDelete(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := srv.CreateAddress(goCtx, types.NewMsgCreateAddress(alice, "ethereum", "gravity", ethAddress1))
+	require.NoError(t, err)
+
+	// a label is used once per owner and network
+	_, err = srv.CreateAddress(goCtx, types.NewMsgCreateAddress(alice, "ethereum", "gravity", ethAddress2))
+	require.ErrorIs(t, err, types.ErrAddressExists)
+	_, err = srv.CreateAddress(goCtx, types.NewMsgCreateAddress(alice, "cosmoshub-4", "gravity", "cosmos1abc"))
+	require.NoError(t, err)
+	_, err = srv.CreateAddress(goCtx, types.NewMsgCreateAddress(bob, "ethereum", "gravity", ethAddress1))
+	require.NoError(t, err)
+
+	// only registered addresses are updated or deleted
+	_, err = srv.UpdateAddress(goCtx, types.NewMsgUpdateAddress(alice, "ethereum", "cold", ethAddress2))
+	require.ErrorIs(t, err, types.ErrUnknownAddress)
+	_, err = srv.DeleteAddress(goCtx, types.NewMsgDeleteAddress(bob, "cosmoshub-4", "gravity"))
+	require.ErrorIs(t, err, types.ErrUnknownAddress)
+
+	_, err = srv.UpdateAddress(goCtx, types.NewMsgUpdateAddress(alice, "ethereum", "gravity", ethAddress2))
+	require.NoError(t, err)
+	address, err := k.GetAddress(ctx, alice, "ethereum", "gravity")
+	require.NoError(t, err)
+	require.Equal(t, ethAddress2, address.Value)
+
+	_, err = srv.DeleteAddress(goCtx, types.NewMsgDeleteAddress(alice, "ethereum", "gravity"))
+	require.NoError(t, err)
+	_, err = k.GetAddress(ctx, alice, "ethereum", "gravity")
+	require.ErrorIs(t, err, types.ErrUnknownAddress)
+}
+
+func TestQueries(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	require.NoError(t, k.CreateAddress(ctx, alice, "ethereum", "gravity", ethAddress1))
+	require.NoError(t, k.CreateAddress(ctx, alice, "ethereum", "cold", ethAddress2))
+	require.NoError(t, k.CreateAddress(ctx, alice, "cosmoshub-4", "hot", "cosmos1abc"))
+	require.NoError(t, k.CreateAddress(ctx, bob, "ethereum", "gravity", ethAddress1))
+
+	res, err := k.Address(goCtx, &types.QueryAddressRequest{Owner: alice.String(), Network: "ethereum", Label: "cold"})
+	require.NoError(t, err)
+	require.Equal(t, ethAddress2, res.Address.Value)
+
+	owned, err := k.Addresses(goCtx, &types.QueryAddressesRequest{Owner: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, owned.Addresses, 3)
+	owned, err = k.Addresses(goCtx, &types.QueryAddressesRequest{Owner: alice.String(), Network: "ethereum"})
+	require.NoError(t, err)
+	require.Len(t, owned.Addresses, 2)
+
+	all, err := k.AllAddresses(goCtx, &types.QueryAllAddressesRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Addresses, 4)
+
+	// the reverse lookup follows updates
+	lookup, err := k.Lookup(goCtx, &types.QueryLookupRequest{Network: "ethereum", Value: ethAddress1})
+	require.NoError(t, err)
+	require.Len(t, lookup.Addresses, 2)
+
+	require.NoError(t, k.UpdateAddress(ctx, bob, "ethereum", "gravity", ethAddress2))
+	lookup, err = k.Lookup(goCtx, &types.QueryLookupRequest{Network: "ethereum", Value: ethAddress1})
+	require.NoError(t, err)
+	require.Equal(t, []types.Address{{Owner: alice.String(), Network: "ethereum", Label: "gravity", Value: ethAddress1}}, lookup.Addresses)
+	lookup, err = k.Lookup(goCtx, &types.QueryLookupRequest{Network: "ethereum", Value: ethAddress2})
+	require.NoError(t, err)
+	require.Len(t, lookup.Addresses, 2)
+}
+
+func TestGenesis(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.NoError(t, k.CreateAddress(ctx, alice, "ethereum", "gravity", ethAddress1))
+	require.NoError(t, k.CreateAddress(ctx, bob, "cosmoshub-4", "hot", "cosmos1abc"))
+
+	genState := addressbook.ExportGenesis(ctx, k)
+	require.NoError(t, genState.Validate())
+	require.Len(t, genState.Addresses, 2)
+
+	imported, importedCtx := setupKeeper(t)
+	addressbook.InitGenesis(importedCtx, imported, *genState)
+	require.Equal(t, genState, addressbook.ExportGenesis(importedCtx, imported))
+
+	lookup, err := imported.Lookup(sdk.WrapSDKContext(importedCtx), &types.QueryLookupRequest{Network: "ethereum", Value: ethAddress1})
+	require.NoError(t, err)
+	require.Len(t, lookup.Addresses, 1)
+
+	// duplicate labels are rejected
+	genState.Addresses = append(genState.Addresses, genState.Addresses[0])
+	require.ErrorIs(t, genState.Validate(), types.ErrInvalidGenesis)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/CudoVentures/cudos-node/x/addressbook/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (m msgServer) CreateAddress(goCtx context.Context, msg *types.MsgCreateAddress) (*types.MsgCreateAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.CreateAddress(ctx, owner, msg.Network, msg.Label, msg.Value); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateAddress,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyNetwork, msg.Network),
+			sdk.NewAttribute(types.AttributeKeyLabel, msg.Label),
+			sdk.NewAttribute(types.AttributeKeyValue, msg.Value),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+
+	return &types.MsgCreateAddressResponse{}, nil
+}
+
+func (m msgServer) UpdateAddress(goCtx context.Context, msg *types.MsgUpdateAddress) (*types.MsgUpdateAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.UpdateAddress(ctx, owner, msg.Network, msg.Label, msg.Value); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateAddress,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyNetwork, msg.Network),
+			sdk.NewAttribute(types.AttributeKeyLabel, msg.Label),
+			sdk.NewAttribute(types.AttributeKeyValue, msg.Value),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+
+	return &types.MsgUpdateAddressResponse{}, nil
+}
+
+func (m msgServer) DeleteAddress(goCtx context.Context, msg *types.MsgDeleteAddress) (*types.MsgDeleteAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := m.Keeper.DeleteAddress(ctx, owner, msg.Network, msg.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeleteAddress,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyNetwork, msg.Network),
+			sdk.NewAttribute(types.AttributeKeyLabel, msg.Label),
+			sdk.NewAttribute(types.AttributeKeyValue, address.Value),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+
+	return &types.MsgDeleteAddressResponse{}, nil
+}
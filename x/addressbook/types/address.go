@@ -0,0 +1,61 @@
+package types
+
+import (
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// networks are lowercase so that they can be used in REST paths, e.g.
+	// ethereum or cosmoshub-4
+	networkRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,63}$`)
+	labelRegex   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,63}$`)
+	valueRegex   = regexp.MustCompile(`^[^\s/]{1,128}$`)
+)
+
+// ValidateNetwork checks that a network is 1 to 64 lowercase alphanumeric
+// characters, dots, underscores or dashes starting with an alphanumeric one.
+func ValidateNetwork(network string) error {
+	if !networkRegex.MatchString(network) {
+		return sdkerrors.Wrapf(ErrInvalidNetwork, "network %q must match %s", network, networkRegex)
+	}
+	return nil
+}
+
+// ValidateLabel checks that a label is 1 to 64 alphanumeric characters,
+// dots, underscores or dashes starting with an alphanumeric one.
+func ValidateLabel(label string) error {
+	if !labelRegex.MatchString(label) {
+		return sdkerrors.Wrapf(ErrInvalidLabel, "label %q must match %s", label, labelRegex)
+	}
+	return nil
+}
+
+// ValidateValue checks that a value is 1 to 128 characters without
+// whitespaces or slashes.
+func ValidateValue(value string) error {
+	if !valueRegex.MatchString(value) {
+		return sdkerrors.Wrapf(ErrInvalidValue, "value %q must match %s", value, valueRegex)
+	}
+	return nil
+}
+
+// Validate checks the fields of an address.
+func (a Address) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	return validateEntry(a.Network, a.Label, a.Value)
+}
+
+func validateEntry(network, label, value string) error {
+	if err := ValidateNetwork(network); err != nil {
+		return err
+	}
+	if err := ValidateLabel(label); err != nil {
+		return err
+	}
+	return ValidateValue(value)
+}
@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/addressbook module sentinel errors
+var (
+	ErrInvalidNetwork = sdkerrors.Register(ModuleName, 1100, "invalid network")
+	ErrInvalidLabel   = sdkerrors.Register(ModuleName, 1101, "invalid label")
+	ErrInvalidValue   = sdkerrors.Register(ModuleName, 1102, "invalid value")
+	ErrAddressExists  = sdkerrors.Register(ModuleName, 1103, "address already exists")
+	ErrUnknownAddress = sdkerrors.Register(ModuleName, 1104, "unknown address")
+	ErrInvalidGenesis = sdkerrors.Register(ModuleName, 1105, "invalid genesis")
+)
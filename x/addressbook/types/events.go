@@ -0,0 +1,13 @@
+package types
+
+// addressbook module event types
+const (
+	EventTypeCreateAddress = "create_address"
+	EventTypeUpdateAddress = "update_address"
+	EventTypeDeleteAddress = "delete_address"
+
+	AttributeKeyOwner   = "owner"
+	AttributeKeyNetwork = "network"
+	AttributeKeyLabel   = "label"
+	AttributeKeyValue   = "value"
+)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(addresses []Address) *GenesisState {
+	return &GenesisState{Addresses: addresses}
+}
+
+// DefaultGenesis returns the default addressbook genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState([]Address{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Addresses))
+	for _, address := range gs.Addresses {
+		if err := address.Validate(); err != nil {
+			return err
+		}
+
+		id := address.Owner + "/" + address.Network + "/" + address.Label
+		if seen[id] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate address %s", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
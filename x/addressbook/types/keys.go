@@ -0,0 +1,72 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "addressbook"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for addressbook
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// AddressKeyPrefix prefixes the addresses, stored under
+	// AddressKeyPrefix | len(owner) | owner | len(network) | network | label.
+	AddressKeyPrefix = []byte{0x01}
+
+	// LookupKeyPrefix prefixes the address index by value, an empty value
+	// stored under LookupKeyPrefix | len(network) | network | len(value) |
+	// value | len(owner) | owner | label.
+	LookupKeyPrefix = []byte{0x02}
+)
+
+// OwnerAddressesKey returns the prefix of the addresses of the given owner.
+func OwnerAddressesKey(owner sdk.AccAddress) []byte {
+	key := append([]byte{}, AddressKeyPrefix...)
+	return appendLengthPrefixed(key, owner)
+}
+
+// NetworkAddressesKey returns the prefix of the addresses of the given owner
+// in a network.
+func NetworkAddressesKey(owner sdk.AccAddress, network string) []byte {
+	return appendLengthPrefixed(OwnerAddressesKey(owner), []byte(network))
+}
+
+// AddressKey returns the key of the address of the given owner, network and
+// label.
+func AddressKey(owner sdk.AccAddress, network, label string) []byte {
+	return append(NetworkAddressesKey(owner, network), []byte(label)...)
+}
+
+// LookupAddressesKey returns the prefix of the index keys of the addresses
+// with the given value in a network.
+func LookupAddressesKey(network, value string) []byte {
+	key := append([]byte{}, LookupKeyPrefix...)
+	key = appendLengthPrefixed(key, []byte(network))
+	return appendLengthPrefixed(key, []byte(value))
+}
+
+// LookupKey returns the index key of an address.
+func LookupKey(owner sdk.AccAddress, network, label, value string) []byte {
+	key := appendLengthPrefixed(LookupAddressesKey(network, value), owner)
+	return append(key, []byte(label)...)
+}
+
+// SplitLookupKey returns the owner and the label of an index key with its
+// LookupAddressesKey prefix removed.
+func SplitLookupKey(key []byte) (sdk.AccAddress, string) {
+	ownerLen := int(key[0])
+	return sdk.AccAddress(key[1 : 1+ownerLen]), string(key[1+ownerLen:])
+}
+
+func appendLengthPrefixed(key, bz []byte) []byte {
+	key = append(key, byte(len(bz)))
+	return append(key, bz...)
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateAddress = "create_address"
+	TypeMsgUpdateAddress = "update_address"
+	TypeMsgDeleteAddress = "delete_address"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateAddress{}
+	_ sdk.Msg = &MsgUpdateAddress{}
+	_ sdk.Msg = &MsgDeleteAddress{}
+)
+
+func NewMsgCreateAddress(owner sdk.AccAddress, network, label, value string) *MsgCreateAddress {
+	return &MsgCreateAddress{Owner: owner.String(), Network: network, Label: label, Value: value}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateAddress) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateAddress) Type() string { return TypeMsgCreateAddress }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	return validateEntry(msg.Network, msg.Label, msg.Value)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Owner)}
+}
+
+func NewMsgUpdateAddress(owner sdk.AccAddress, network, label, value string) *MsgUpdateAddress {
+	return &MsgUpdateAddress{Owner: owner.String(), Network: network, Label: label, Value: value}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateAddress) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateAddress) Type() string { return TypeMsgUpdateAddress }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	return validateEntry(msg.Network, msg.Label, msg.Value)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Owner)}
+}
+
+func NewMsgDeleteAddress(owner sdk.AccAddress, network, label string) *MsgDeleteAddress {
+	return &MsgDeleteAddress{Owner: owner.String(), Network: network, Label: label}
+}
+
+// Route Implements Msg.
+func (msg MsgDeleteAddress) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgDeleteAddress) Type() string { return TypeMsgDeleteAddress }
+
+// ValidateBasic Implements Msg.
+func (msg MsgDeleteAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	if err := ValidateNetwork(msg.Network); err != nil {
+		return err
+	}
+	return ValidateLabel(msg.Label)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgDeleteAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDeleteAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Owner)}
+}
+
+func mustAccAddress(addr string) sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		panic(err)
+	}
+	return accAddr
+}
@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+)
+
+// VestingSpendProposalJSON is the proposal file read by
+// NewCmdSubmitVestingSpendProposal.
+type VestingSpendProposalJSON struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	Recipient   string              `json:"recipient"`
+	StartTime   int64               `json:"start_time"`
+	Periods     []VestingPeriodJSON `json:"periods"`
+	Deposit     string              `json:"deposit"`
+}
+
+// VestingPeriodJSON unlocks coins length_seconds after the previous period.
+type VestingPeriodJSON struct {
+	Coins         string `json:"coins"`
+	LengthSeconds int64  `json:"length_seconds"`
+}
+
+// ParseVestingSpendProposalJSON reads and parses a VestingSpendProposalJSON
+// from a file.
+func ParseVestingSpendProposalJSON(proposalFile string) (VestingSpendProposalJSON, error) {
+	proposal := VestingSpendProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// NewCmdSubmitVestingSpendProposal implements the command to submit a
+// community pool vesting spend proposal.
+func NewCmdSubmitVestingSpendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-vesting-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool spend proposal paid out over a vesting schedule",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool spend proposal along with an initial deposit.
+The spend is held by the admin module and released to the recipient as the
+periods of the schedule unlock, governance can claw back what did not unlock
+yet. The first period starts at start_time, or when the proposal passes if it
+is zero. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-vesting-spend <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Vesting Spend",
+  "description": "Fund the grant over two months",
+  "recipient": "cudos1...",
+  "start_time": 0,
+  "periods": [
+    {"coins": "1000000acudos", "length_seconds": 2592000},
+    {"coins": "1000000acudos", "length_seconds": 2592000}
+  ],
+  "deposit": "1000acudos"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseVestingSpendProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(proposal.Recipient)
+			if err != nil {
+				return err
+			}
+
+			periods := make([]types.VestingPeriod, len(proposal.Periods))
+			for i, period := range proposal.Periods {
+				amount, err := sdk.ParseCoinsNormalized(period.Coins)
+				if err != nil {
+					return fmt.Errorf("failed to parse coins of vesting period %d: %w", i, err)
+				}
+				periods[i] = types.VestingPeriod{Length: period.LengthSeconds, Amount: amount}
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCommunityPoolVestingSpendProposal(proposal.Title, proposal.Description, recipient, proposal.StartTime, periods)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// NewCmdSubmitClawbackVestingSpendProposal implements the command to submit
+// a clawback vesting spend proposal.
+func NewCmdSubmitClawbackVestingSpendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback-vesting-spend [vesting-spend-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal returning what did not unlock yet of a vesting spend to the community pool",
+		Example: fmt.Sprintf(
+			"$ %s tx gov submit-proposal clawback-vesting-spend 1 --title=<title> --description=<description> --deposit=1000acudos --from=<key_or_address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewClawbackVestingSpendProposal(title, description, id)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -2,10 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/spf13/cobra"
 
@@ -14,6 +17,11 @@ import (
 	"github.com/CudoVentures/cudos-node/x/admin/types"
 )
 
+const (
+	FlagAllowedRecipients = "allowed-recipients"
+	FlagExpiration        = "expiration"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,6 +34,7 @@ func GetTxCmd() *cobra.Command {
 
 	// this line is used by starport scaffolding # 1
 	cmd.AddCommand(CmdAdminSpendCommunityPool())
+	cmd.AddCommand(CmdGrantAdminSpend())
 
 	return cmd
 }
@@ -62,3 +71,63 @@ func CmdAdminSpendCommunityPool() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGrantAdminSpend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-spend [grantee] [spend_limit]",
+		Short: "Allow a grantee to spend up to a limit of the community pool on behalf of an admin",
+		Example: fmt.Sprintf(
+			"$ %s tx admin grant-spend cudos1... 1000000000000000000acudos --allowed-recipients cudos1...,cudos1... --expiration 1893456000 --from admin",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			spendLimit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			recipientStrs, err := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+			if err != nil {
+				return err
+			}
+			recipients := make([]sdk.AccAddress, len(recipientStrs))
+			for i, recipientStr := range recipientStrs {
+				if recipients[i], err = sdk.AccAddressFromBech32(recipientStr); err != nil {
+					return err
+				}
+			}
+
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			expiration := time.Unix(exp, 0)
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewAdminSpendAuthorization(spendLimit, recipients, &expiration)
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedRecipients, []string{}, "Addresses the grantee can spend to, any address if empty")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "Unix timestamp after which the grantee can no longer spend, one year by default")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,275 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/CudoVentures/cudos-node/testutil/network"
+	"github.com/CudoVentures/cudos-node/x/admin/client/cli"
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzcli "github.com/cosmos/cosmos-sdk/x/authz/client/cli"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupcli "github.com/cosmos/cosmos-sdk/x/group/client/cli"
+)
+
+type TxTestSuite struct {
+	suite.Suite
+
+	cfg network.Config
+	net *network.Network
+}
+
+func TestTxTestSuite(t *testing.T) {
+	suite.Run(t, new(TxTestSuite))
+}
+
+// SetupSuite starts a network whose validator holds admin tokens and whose
+// community pool holds 1000 bond tokens.
+func (s *TxTestSuite) SetupSuite() {
+	s.cfg = network.DefaultConfig()
+	communityPool := sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1000))
+
+	var distrGenState distrtypes.GenesisState
+	s.cfg.Codec.MustUnmarshalJSON(s.cfg.GenesisState[distrtypes.ModuleName], &distrGenState)
+	distrGenState.FeePool.CommunityPool = sdk.NewDecCoinsFromCoins(communityPool...)
+	s.cfg.GenesisState[distrtypes.ModuleName] = s.cfg.Codec.MustMarshalJSON(&distrGenState)
+
+	var bankGenState banktypes.GenesisState
+	s.cfg.Codec.MustUnmarshalJSON(s.cfg.GenesisState[banktypes.ModuleName], &bankGenState)
+	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+		Coins:   communityPool,
+	})
+	s.cfg.GenesisState[banktypes.ModuleName] = s.cfg.Codec.MustMarshalJSON(&bankGenState)
+
+	s.net = network.New(s.T(), s.cfg)
+	_, err := s.net.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+// TestAdminSpendLegacyAminoJSON signs an admin spend the way a Ledger does,
+// with SIGN_MODE_LEGACY_AMINO_JSON, and checks that the chain accepts it.
+func (s *TxTestSuite) TestAdminSpendLegacyAminoJSON() {
+	val := s.net.Validators[0]
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	spend := sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 400))
+
+	// wallets encode the msg with the registered amino type name
+	msg := types.NewMsgAdminSpendCommunityPool(val.Address, recipient, spend)
+	s.Require().Equal(sdk.MustSortJSON(s.cfg.LegacyAmino.MustMarshalJSON(msg)), msg.GetSignBytes())
+	s.Require().Contains(string(msg.GetSignBytes()), `"type":"admin/AdminSpendCommunityPool"`)
+
+	s.Require().Equal(uint32(0), s.execTx(cli.CmdAdminSpendCommunityPool(), recipient.String(), spend.String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
+	))
+	s.Require().Equal(spend, s.balances(recipient))
+}
+
+// TestGrantAdminSpend grants an AdminSpendAuthorization to a hot wallet and
+// executes spends within and beyond its bounds.
+func (s *TxTestSuite) TestGrantAdminSpend() {
+	val := s.net.Validators[0]
+	treasury := sdk.AccAddress([]byte("treasury____________"))
+	other := sdk.AccAddress([]byte("other_______________"))
+
+	info, _, err := val.ClientCtx.Keyring.NewMnemonic("hotwallet", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	hotWallet := info.GetAddress()
+	s.Require().Equal(uint32(0), s.execTx(bankcli.NewSendTxCmd(), val.Address.String(), hotWallet.String(), sdk.NewInt64Coin(s.cfg.BondDenom, 1000).String()))
+
+	s.Require().Equal(uint32(0), s.execTx(cli.CmdGrantAdminSpend(), hotWallet.String(), sdk.NewInt64Coin(s.cfg.BondDenom, 300).String(),
+		fmt.Sprintf("--%s=%s", cli.FlagAllowedRecipients, treasury),
+		fmt.Sprintf("--%s=%d", cli.FlagExpiration, time.Now().Add(time.Hour).Unix()),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+	))
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, authzcli.GetCmdQueryGrants(), []string{
+		val.Address.String(),
+		hotWallet.String(),
+		sdk.MsgTypeURL(&types.MsgAdminSpendCommunityPool{}),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var grants authz.QueryGrantsResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &grants), out.String())
+	s.Require().Len(grants.Grants, 1)
+	var authorization authz.Authorization
+	s.Require().NoError(val.ClientCtx.InterfaceRegistry.UnpackAny(grants.Grants[0].Authorization, &authorization))
+	s.Require().IsType(&types.AdminSpendAuthorization{}, authorization)
+
+	s.Require().Equal(uint32(0), s.execSpend(hotWallet, treasury, 200))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 200)), s.balances(treasury))
+
+	// beyond the remaining limit or to other recipients
+	s.Require().NotEqual(uint32(0), s.execSpend(hotWallet, treasury, 101))
+	s.Require().NotEqual(uint32(0), s.execSpend(hotWallet, other, 1))
+	s.Require().Empty(s.balances(other))
+}
+
+// TestGroupCouncil turns the admin council into a group whose policy spends
+// from the community pool once enough members voted for it.
+func (s *TxTestSuite) TestGroupCouncil() {
+	val := s.net.Validators[0]
+	recipient := sdk.AccAddress([]byte("council_recipient___"))
+	spend := sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 100))
+
+	member := s.newFundedKey("councilmember")
+	outsider := s.newFundedKey("outsider")
+
+	// a group administered by an admin can spend, 2 of 2 members have to agree
+	policy := s.createGroupWithPolicy(val.Address, `{"@type":"/cosmos.group.v1.ThresholdDecisionPolicy","threshold":"2","windows":{"voting_period":"1h","min_execution_period":"0s"}}`, val.Address, member)
+	proposalID := s.submitSpendProposal(policy, member, recipient, spend)
+
+	s.Require().Equal(uint32(0), s.execTx(groupcli.MsgExecCmd(), proposalID, fmt.Sprintf("--%s=%s", flags.FlagFrom, member)))
+	s.Require().Empty(s.balances(recipient))
+
+	s.Require().Equal(uint32(0), s.execTx(groupcli.MsgVoteCmd(), proposalID, val.Address.String(), group.VOTE_OPTION_YES.String(), ""))
+	s.Require().Equal(uint32(0), s.execTx(groupcli.MsgExecCmd(), proposalID, fmt.Sprintf("--%s=%s", flags.FlagFrom, member)))
+	s.Require().Equal(spend, s.balances(recipient))
+
+	// a group administered by someone else cannot
+	policy = s.createGroupWithPolicy(outsider, `{"@type":"/cosmos.group.v1.ThresholdDecisionPolicy","threshold":"1","windows":{"voting_period":"1h","min_execution_period":"0s"}}`, outsider)
+	other := sdk.AccAddress([]byte("outsider_recipient__"))
+	proposalID = s.submitSpendProposal(policy, outsider, other, spend)
+	s.Require().Equal(uint32(0), s.execTx(groupcli.MsgExecCmd(), proposalID, fmt.Sprintf("--%s=%s", flags.FlagFrom, outsider)))
+	s.Require().Empty(s.balances(other))
+}
+
+// newFundedKey adds a key to the validator keyring and funds it with 1000 bond
+// tokens.
+func (s *TxTestSuite) newFundedKey(uid string) sdk.AccAddress {
+	val := s.net.Validators[0]
+	info, _, err := val.ClientCtx.Keyring.NewMnemonic(uid, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), s.execTx(bankcli.NewSendTxCmd(), val.Address.String(), info.GetAddress().String(), sdk.NewInt64Coin(s.cfg.BondDenom, 1000).String()))
+	return info.GetAddress()
+}
+
+// createGroupWithPolicy creates a group of equally weighted members with a
+// single decision policy, both administered by admin, and returns the policy
+// account.
+func (s *TxTestSuite) createGroupWithPolicy(admin sdk.AccAddress, decisionPolicy string, members ...sdk.AccAddress) sdk.AccAddress {
+	val := s.net.Validators[0]
+
+	memberRequests := make([]group.MemberRequest, len(members))
+	for i, member := range members {
+		memberRequests[i] = group.MemberRequest{Address: member.String(), Weight: "1"}
+	}
+	membersJSON, err := json.Marshal(map[string]interface{}{"members": memberRequests})
+	s.Require().NoError(err)
+	membersFile := testutil.WriteToNewTempFile(s.T(), string(membersJSON))
+
+	s.Require().Equal(uint32(0), s.execTx(groupcli.MsgCreateGroupWithPolicyCmd(), admin.String(), "", "", membersFile.Name(), decisionPolicy))
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, groupcli.QueryGroupPoliciesByAdminCmd(), []string{
+		admin.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var res group.QueryGroupPoliciesByAdminResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().NotEmpty(res.GroupPolicies)
+
+	policy, err := sdk.AccAddressFromBech32(res.GroupPolicies[len(res.GroupPolicies)-1].Address)
+	s.Require().NoError(err)
+	return policy
+}
+
+// submitSpendProposal proposes an admin spend of the group policy and returns
+// the proposal id, the proposer votes yes.
+func (s *TxTestSuite) submitSpendProposal(policy, proposer, recipient sdk.AccAddress, spend sdk.Coins) string {
+	val := s.net.Validators[0]
+
+	msgJSON, err := val.ClientCtx.Codec.MarshalInterfaceJSON(types.NewMsgAdminSpendCommunityPool(policy, recipient, spend))
+	s.Require().NoError(err)
+	proposalJSON, err := json.Marshal(map[string]interface{}{
+		"group_policy_address": policy.String(),
+		"messages":             []json.RawMessage{msgJSON},
+		"proposers":            []string{proposer.String()},
+	})
+	s.Require().NoError(err)
+	proposalFile := testutil.WriteToNewTempFile(s.T(), string(proposalJSON))
+
+	s.Require().Equal(uint32(0), s.execTx(groupcli.MsgSubmitProposalCmd(), proposalFile.Name()))
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, groupcli.QueryProposalsByGroupPolicyCmd(), []string{
+		policy.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var res group.QueryProposalsByGroupPolicyResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().Len(res.Proposals, 1)
+	proposalID := fmt.Sprintf("%d", res.Proposals[0].Id)
+
+	s.Require().Equal(uint32(0), s.execTx(groupcli.MsgVoteCmd(), proposalID, proposer.String(), group.VOTE_OPTION_YES.String(), ""))
+	return proposalID
+}
+
+// execSpend executes an admin spend of the validator from the grantee and
+// returns the result code.
+func (s *TxTestSuite) execSpend(grantee, recipient sdk.AccAddress, amount int64) uint32 {
+	val := s.net.Validators[0]
+	spend := sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, amount))
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdAdminSpendCommunityPool(), []string{
+		recipient.String(),
+		spend.String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	})
+	s.Require().NoError(err)
+	txFile := testutil.WriteToNewTempFile(s.T(), out.String())
+
+	return s.execTx(authzcli.NewCmdExecAuthorization(), txFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, grantee),
+	)
+}
+
+// execTx broadcasts a tx command in block mode and returns the result code.
+func (s *TxTestSuite) execTx(cmd *cobra.Command, args ...string) uint32 {
+	val := s.net.Validators[0]
+	args = append(args,
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewInt64Coin(s.cfg.BondDenom, 10)),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	)
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+	s.Require().NoError(err)
+
+	var res sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	return res.Code
+}
+
+func (s *TxTestSuite) balances(addr sdk.AccAddress) sdk.Coins {
+	val := s.net.Validators[0]
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, bankcli.GetBalancesCmd(), []string{
+		addr.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var balances banktypes.QueryAllBalancesResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &balances), out.String())
+	return balances.Balances
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	"github.com/CudoVentures/cudos-node/x/admin/client/cli"
+	"github.com/CudoVentures/cudos-node/x/admin/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	// VestingSpendProposalHandler is the community pool vesting spend proposal handler.
+	VestingSpendProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitVestingSpendProposal, rest.VestingSpendProposalRESTHandler)
+	// ClawbackVestingSpendProposalHandler is the clawback vesting spend proposal handler.
+	ClawbackVestingSpendProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitClawbackVestingSpendProposal, rest.ClawbackVestingSpendProposalRESTHandler)
+)
@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+)
+
+// VestingSpendProposalReq defines a community pool vesting spend proposal
+// request body.
+type VestingSpendProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string                `json:"title" yaml:"title"`
+	Description string                `json:"description" yaml:"description"`
+	Recipient   sdk.AccAddress        `json:"recipient" yaml:"recipient"`
+	StartTime   int64                 `json:"start_time" yaml:"start_time"`
+	Periods     []types.VestingPeriod `json:"periods" yaml:"periods"`
+	Proposer    sdk.AccAddress        `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins             `json:"deposit" yaml:"deposit"`
+}
+
+// ClawbackVestingSpendProposalReq defines a clawback vesting spend proposal
+// request body.
+type ClawbackVestingSpendProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title          string         `json:"title" yaml:"title"`
+	Description    string         `json:"description" yaml:"description"`
+	VestingSpendID uint64         `json:"vesting_spend_id" yaml:"vesting_spend_id"`
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit        sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// VestingSpendProposalRESTHandler returns the community pool vesting spend
+// proposal REST handler.
+func VestingSpendProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_vesting_spend",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req VestingSpendProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewCommunityPoolVestingSpendProposal(req.Title, req.Description, req.Recipient, req.StartTime, req.Periods)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// ClawbackVestingSpendProposalRESTHandler returns the clawback vesting spend
+// proposal REST handler.
+func ClawbackVestingSpendProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "clawback_vesting_spend",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ClawbackVestingSpendProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewClawbackVestingSpendProposal(req.Title, req.Description, req.VestingSpendID)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func writeProposalTx(clientCtx client.Context, w http.ResponseWriter, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
@@ -8,15 +8,29 @@ import (
 
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
-func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, genState types.GenesisState) {
+	// create the account holding the vesting spends if it does not exist yet
+	ak.GetModuleAccount(ctx, types.ModuleName)
+
+	if genState.NextVestingSpendId != 0 {
+		k.SetNextVestingSpendID(ctx, genState.NextVestingSpendId)
+	}
+
+	for _, vestingSpend := range genState.VestingSpends {
+		k.SetVestingSpend(ctx, vestingSpend)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	genesis := types.DefaultGenesis()
+	vestingSpends := []types.VestingSpend{}
+	k.IterateVestingSpends(ctx, func(vestingSpend types.VestingSpend) bool {
+		vestingSpends = append(vestingSpends, vestingSpend)
+		return false
+	})
 
 	// this line is used by starport scaffolding # genesis/module/export
 
-	return genesis
+	return types.NewGenesisState(vestingSpends, k.GetNextVestingSpendID(ctx))
 }
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// IsAdmin reports whether addr may use the admin messages.
+//
+// Holders of the admin token are admins. A group policy account is an admin too
+// when everyone able to change it, i.e. the admin of the policy and the admin of
+// its group, is an admin, since they could spend through it anyway. Admin roles
+// held by the policy itself do not count, so a group that only governs itself
+// has to hold the admin token.
+func (k Keeper) IsAdmin(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.isAdmin(ctx, addr, map[string]bool{})
+}
+
+// isAdmin memoizes its results in known. Addresses still being resolved are
+// known as non admins, which rejects policies administering each other.
+func (k Keeper) isAdmin(ctx sdk.Context, addr sdk.AccAddress, known map[string]bool) bool {
+	if isAdmin, ok := known[addr.String()]; ok {
+		return isAdmin
+	}
+	known[addr.String()] = false
+
+	isAdmin := k.bankKeeper.GetBalance(ctx, addr, types.AdminDenom).IsPositive() ||
+		k.isAdminGroupPolicy(ctx, addr, known)
+	known[addr.String()] = isAdmin
+	return isAdmin
+}
+
+func (k Keeper) isAdminGroupPolicy(ctx sdk.Context, addr sdk.AccAddress, known map[string]bool) bool {
+	if k.groupKeeper == nil {
+		return false
+	}
+
+	controllers, ok := k.groupPolicyControllers(ctx, addr)
+	if !ok {
+		return false
+	}
+
+	external := 0
+	for _, controller := range controllers {
+		if controller == addr.String() {
+			continue
+		}
+		controllerAddr, err := sdk.AccAddressFromBech32(controller)
+		if err != nil || !k.isAdmin(ctx, controllerAddr, known) {
+			return false
+		}
+		external++
+	}
+
+	return external > 0
+}
+
+// groupPolicyControllers returns the admins of the group policy at addr and of
+// its group, or false when addr is not a group policy account.
+func (k Keeper) groupPolicyControllers(ctx sdk.Context, addr sdk.AccAddress) ([]string, bool) {
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	policyRes, err := k.groupKeeper.GroupPolicyInfo(goCtx, &group.QueryGroupPolicyInfoRequest{Address: addr.String()})
+	if err != nil || policyRes.Info == nil {
+		return nil, false
+	}
+
+	groupRes, err := k.groupKeeper.GroupInfo(goCtx, &group.QueryGroupInfoRequest{GroupId: policyRes.Info.GroupId})
+	if err != nil || groupRes.Info == nil {
+		return nil, false
+	}
+
+	return []string{policyRes.Info.Admin, groupRes.Info.Admin}, true
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/admin/keeper"
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+type mockGroupKeeper struct {
+	groups   map[uint64]string
+	policies map[string]group.GroupPolicyInfo
+}
+
+func (gk mockGroupKeeper) GroupInfo(goCtx context.Context, req *group.QueryGroupInfoRequest) (*group.QueryGroupInfoResponse, error) {
+	admin, ok := gk.groups[req.GroupId]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound
+	}
+	return &group.QueryGroupInfoResponse{Info: &group.GroupInfo{Id: req.GroupId, Admin: admin}}, nil
+}
+
+func (gk mockGroupKeeper) GroupPolicyInfo(goCtx context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	info, ok := gk.policies[req.Address]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound
+	}
+	return &group.QueryGroupPolicyInfoResponse{Info: &info}, nil
+}
+
+func TestIsAdmin(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	user := sdk.AccAddress("user________________")
+	councilPolicy := sdk.AccAddress("council_policy______")
+	selfPolicy := sdk.AccAddress("self_policy_________")
+	mixedPolicy := sdk.AccAddress("mixed_policy________")
+	nestedPolicy := sdk.AccAddress("nested_policy_______")
+	cyclePolicyA := sdk.AccAddress("cycle_policy_a______")
+	cyclePolicyB := sdk.AccAddress("cycle_policy_b______")
+
+	bk := keepertest.NewBankKeeper()
+	bk.Balances[admin.String()] = sdk.NewCoins(sdk.NewInt64Coin(types.AdminDenom, 1))
+	gk := mockGroupKeeper{
+		groups: map[uint64]string{
+			1: admin.String(),
+			2: selfPolicy.String(),
+			3: admin.String(),
+			4: councilPolicy.String(),
+			5: cyclePolicyB.String(),
+		},
+		policies: map[string]group.GroupPolicyInfo{
+			councilPolicy.String(): {Address: councilPolicy.String(), GroupId: 1, Admin: councilPolicy.String()},
+			selfPolicy.String():    {Address: selfPolicy.String(), GroupId: 2, Admin: selfPolicy.String()},
+			mixedPolicy.String():   {Address: mixedPolicy.String(), GroupId: 3, Admin: user.String()},
+			nestedPolicy.String():  {Address: nestedPolicy.String(), GroupId: 4, Admin: councilPolicy.String()},
+			cyclePolicyA.String():  {Address: cyclePolicyA.String(), GroupId: 5, Admin: cyclePolicyA.String()},
+			cyclePolicyB.String():  {Address: cyclePolicyB.String(), GroupId: 5, Admin: cyclePolicyA.String()},
+		},
+	}
+	k := keeper.NewKeeper(nil, nil, nil, nil, bk, gk)
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	for _, tc := range []struct {
+		name    string
+		addr    sdk.AccAddress
+		isAdmin bool
+	}{
+		{"admin token holder", admin, true},
+		{"plain account", user, false},
+		{"policy of a group administered by an admin", councilPolicy, true},
+		{"policy of a group governing itself", selfPolicy, false},
+		{"policy administered by a non admin", mixedPolicy, false},
+		{"policy administered by an admin policy", nestedPolicy, true},
+		{"policies administering each other", cyclePolicyA, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.isAdmin, k.IsAdmin(ctx, tc.addr))
+		})
+	}
+
+	t.Run("without group keeper", func(t *testing.T) {
+		k := keeper.NewKeeper(nil, nil, nil, nil, bk, nil)
+		require.True(t, k.IsAdmin(ctx, admin))
+		require.False(t, k.IsAdmin(ctx, councilPolicy))
+	})
+}
@@ -1,7 +1,51 @@
 package keeper
 
 import (
+	"context"
+
 	"github.com/CudoVentures/cudos-node/x/admin/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
+
+func (k Keeper) VestingSpend(goCtx context.Context, req *types.QueryVestingSpendRequest) (*types.QueryVestingSpendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingSpend, found := k.GetVestingSpend(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vesting spend %d not found", req.Id)
+	}
+
+	return &types.QueryVestingSpendResponse{VestingSpend: vestingSpend}, nil
+}
+
+func (k Keeper) VestingSpends(goCtx context.Context, req *types.QueryVestingSpendsRequest) (*types.QueryVestingSpendsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingSpends := []types.VestingSpend{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingSpendKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var vestingSpend types.VestingSpend
+		if err := k.cdc.Unmarshal(value, &vestingSpend); err != nil {
+			return err
+		}
+		vestingSpends = append(vestingSpends, vestingSpend)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVestingSpendsResponse{VestingSpends: vestingSpends, Pagination: pageRes}, nil
+}
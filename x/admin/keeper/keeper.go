@@ -17,17 +17,21 @@ type (
 		memKey             sdk.StoreKey
 		distributionKeeper types.DistributionKeeper
 		bankKeeper         types.BankKeeper
+		groupKeeper        types.GroupKeeper
 	}
 )
 
+// NewKeeper creates the admin keeper. The group keeper is optional, without it
+// only holders of the admin token are admins.
 func NewKeeper(cdc codec.Codec, storeKey, memKey sdk.StoreKey,
-	dk types.DistributionKeeper, bk types.BankKeeper) *Keeper {
+	dk types.DistributionKeeper, bk types.BankKeeper, gk types.GroupKeeper) *Keeper {
 	return &Keeper{
 		cdc:                cdc,
 		storeKey:           storeKey,
 		memKey:             memKey,
 		distributionKeeper: dk,
 		bankKeeper:         bk,
+		groupKeeper:        gk,
 	}
 }
 
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/admin/keeper"
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+type mockDistributionKeeper struct {
+	bk      *keepertest.BankKeeper
+	feePool distrtypes.FeePool
+}
+
+func (dk *mockDistributionKeeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	communityPool, hasNeg := dk.feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	dk.feePool.CommunityPool = communityPool
+	return dk.bk.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, receiveAddr, amount)
+}
+
+func (dk *mockDistributionKeeper) GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins {
+	return dk.feePool.CommunityPool
+}
+
+func (dk *mockDistributionKeeper) GetFeePool(ctx sdk.Context) distrtypes.FeePool {
+	return dk.feePool
+}
+
+func (dk *mockDistributionKeeper) SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool) {
+	dk.feePool = feePool
+}
+
+func (dk *mockDistributionKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if err := dk.bk.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount); err != nil {
+		return err
+	}
+	dk.feePool.CommunityPool = dk.feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...)
+	return nil
+}
+
+// setupKeeper returns a keeper whose community pool holds 1000stake.
+func setupKeeper(t *testing.T) (keeper.Keeper, *keepertest.BankKeeper, *mockDistributionKeeper, sdk.Context) {
+	stores := keepertest.NewStores()
+	storeKey := stores.KVStoreKey(types.StoreKey)
+
+	communityPool := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	bk := keepertest.NewBankKeeper()
+	bk.Balances[authtypes.NewModuleAddress(distrtypes.ModuleName).String()] = communityPool
+	dk := &mockDistributionKeeper{bk: bk, feePool: distrtypes.FeePool{CommunityPool: sdk.NewDecCoinsFromCoins(communityPool...)}}
+	k := keeper.NewKeeper(stores.Codec, storeKey, nil, dk, bk, nil)
+
+	return *k, bk, dk, stores.Context(t, tmproto.Header{Time: time.Unix(1000000, 0)})
+}
+
+func stake(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+}
+
+func TestVestingSpend(t *testing.T) {
+	k, bk, dk, ctx := setupKeeper(t)
+	recipient := sdk.AccAddress("recipient___________")
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	periods := []types.VestingPeriod{
+		{Length: 100, Amount: stake(100)},
+		{Length: 100, Amount: stake(200)},
+		{Length: 100, Amount: stake(300)},
+	}
+	id, err := k.CreateVestingSpend(ctx, recipient, 0, periods)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, stake(600), bk.Balances[moduleAddr.String()])
+	require.Equal(t, sdk.NewDecCoinsFromCoins(stake(400)...), dk.feePool.CommunityPool)
+
+	vestingSpend, found := k.GetVestingSpend(ctx, id)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Unix(), vestingSpend.StartTime)
+
+	// nothing unlocked before the first period ends
+	k.ReleaseVestingSpends(ctx.WithBlockTime(ctx.BlockTime().Add(99 * time.Second)))
+	require.Empty(t, bk.Balances[recipient.String()])
+
+	k.ReleaseVestingSpends(ctx.WithBlockTime(ctx.BlockTime().Add(250 * time.Second)))
+	require.Equal(t, stake(300), bk.Balances[recipient.String()])
+	vestingSpend, _ = k.GetVestingSpend(ctx, id)
+	require.Equal(t, stake(300), vestingSpend.Released)
+
+	// released amounts are not paid twice
+	k.ReleaseVestingSpends(ctx.WithBlockTime(ctx.BlockTime().Add(299 * time.Second)))
+	require.Equal(t, stake(300), bk.Balances[recipient.String()])
+
+	k.ReleaseVestingSpends(ctx.WithBlockTime(ctx.BlockTime().Add(300 * time.Second)))
+	require.Equal(t, stake(600), bk.Balances[recipient.String()])
+	require.Empty(t, bk.Balances[moduleAddr.String()])
+	_, found = k.GetVestingSpend(ctx, id)
+	require.False(t, found)
+}
+
+func TestCreateVestingSpendFails(t *testing.T) {
+	k, bk, _, ctx := setupKeeper(t)
+	recipient := sdk.AccAddress("recipient___________")
+
+	_, err := k.CreateVestingSpend(ctx, recipient, 0, []types.VestingPeriod{{Length: 100, Amount: stake(1001)}})
+	require.ErrorIs(t, err, types.ErrInsufficientCommunityPool)
+
+	_, err = k.CreateVestingSpend(ctx, recipient, 0, []types.VestingPeriod{{Length: 0, Amount: stake(1)}})
+	require.ErrorIs(t, err, types.ErrInvalidVestingSpend)
+
+	bk.Blocked[recipient.String()] = true
+	_, err = k.CreateVestingSpend(ctx, recipient, 0, []types.VestingPeriod{{Length: 100, Amount: stake(1)}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestClawbackVestingSpend(t *testing.T) {
+	k, bk, dk, ctx := setupKeeper(t)
+	recipient := sdk.AccAddress("recipient___________")
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	id, err := k.CreateVestingSpend(ctx, recipient, ctx.BlockTime().Unix()-150, []types.VestingPeriod{
+		{Length: 100, Amount: stake(100)},
+		{Length: 100, Amount: stake(200)},
+	})
+	require.NoError(t, err)
+
+	// what unlocked already goes to the recipient, the rest back to the pool
+	unvested, err := k.ClawbackVestingSpend(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, stake(200), unvested)
+	require.Equal(t, stake(100), bk.Balances[recipient.String()])
+	require.Empty(t, bk.Balances[moduleAddr.String()])
+	require.Equal(t, sdk.NewDecCoinsFromCoins(stake(900)...), dk.feePool.CommunityPool)
+
+	_, found := k.GetVestingSpend(ctx, id)
+	require.False(t, found)
+	_, err = k.ClawbackVestingSpend(ctx, id)
+	require.ErrorIs(t, err, types.ErrVestingSpendNotFound)
+}
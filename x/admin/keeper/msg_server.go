@@ -20,10 +20,8 @@ func (m msgServer) AdminSpendCommunityPool(goCtx context.Context, proposal *type
 		return nil, err
 	}
 
-	balance := m.Keeper.bankKeeper.GetBalance(ctx, initiatorAddr, types.AdminDenom)
-
-	if !balance.IsPositive() {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "Insufficient permissions. Address '%s' has no %s tokens", initiatorAddr, types.AdminDenom)
+	if !m.Keeper.IsAdmin(ctx, initiatorAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "Insufficient permissions. Address '%s' has no %s tokens and is not a group policy controlled by admins", initiatorAddr, types.AdminDenom)
 	}
 
 	to, err := sdk.AccAddressFromBech32(proposal.ToAddress)
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// CreateVestingSpend moves the sum of the periods from the community pool to
+// the admin module, which releases it to the recipient as the periods unlock.
+// A zero start time starts the first period at the current block time.
+func (k Keeper) CreateVestingSpend(ctx sdk.Context, recipient sdk.AccAddress, startTime int64, periods []types.VestingPeriod) (uint64, error) {
+	if err := types.ValidateVestingPeriods(periods); err != nil {
+		return 0, err
+	}
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+	if startTime == 0 {
+		startTime = ctx.BlockTime().Unix()
+	}
+
+	// the admin module account is blocked, so the pool cannot pay it out
+	// with DistributeFromFeePool
+	amount := types.VestingPeriodsAmount(periods)
+	feePool := k.distributionKeeper.GetFeePool(ctx)
+	communityPool, hasNeg := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+	if hasNeg {
+		return 0, sdkerrors.Wrapf(types.ErrInsufficientCommunityPool, "%s is more than the community pool holds", amount)
+	}
+	feePool.CommunityPool = communityPool
+	k.distributionKeeper.SetFeePool(ctx, feePool)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, amount); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextVestingSpendID(ctx)
+	k.SetNextVestingSpendID(ctx, id+1)
+	k.SetVestingSpend(ctx, types.NewVestingSpend(id, recipient, startTime, periods))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateVestingSpend,
+		sdk.NewAttribute(types.AttributeKeyVestingSpendID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+
+	return id, nil
+}
+
+// ReleaseVestingSpend sends the unlocked amount of the vesting spend that was
+// not released yet to its recipient and deletes the spend once all of it was
+// released.
+func (k Keeper) ReleaseVestingSpend(ctx sdk.Context, vestingSpend types.VestingSpend) error {
+	releasable := vestingSpend.Releasable(ctx.BlockTime())
+	if releasable.IsZero() {
+		return nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(vestingSpend.Recipient)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, releasable); err != nil {
+		return err
+	}
+
+	vestingSpend.Released = vestingSpend.Released.Add(releasable...)
+	if vestingSpend.Unreleased().IsZero() {
+		k.DeleteVestingSpend(ctx, vestingSpend.Id)
+	} else {
+		k.SetVestingSpend(ctx, vestingSpend)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReleaseVestingSpend,
+		sdk.NewAttribute(types.AttributeKeyVestingSpendID, strconv.FormatUint(vestingSpend.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, vestingSpend.Recipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, releasable.String()),
+	))
+
+	return nil
+}
+
+// ReleaseVestingSpends releases the unlocked amounts of all vesting spends.
+// A spend failing to release is logged and retried in the next block.
+func (k Keeper) ReleaseVestingSpends(ctx sdk.Context) {
+	var vestingSpends []types.VestingSpend
+	k.IterateVestingSpends(ctx, func(vestingSpend types.VestingSpend) bool {
+		vestingSpends = append(vestingSpends, vestingSpend)
+		return false
+	})
+
+	for _, vestingSpend := range vestingSpends {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.ReleaseVestingSpend(cacheCtx, vestingSpend); err != nil {
+			k.Logger(ctx).Error("failed to release vesting spend", "id", vestingSpend.Id, "err", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// ClawbackVestingSpend releases what already unlocked, returns the rest of the
+// vesting spend to the community pool and deletes the spend.
+func (k Keeper) ClawbackVestingSpend(ctx sdk.Context, id uint64) (sdk.Coins, error) {
+	vestingSpend, found := k.GetVestingSpend(ctx, id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrVestingSpendNotFound, "%d", id)
+	}
+
+	if err := k.ReleaseVestingSpend(ctx, vestingSpend); err != nil {
+		return nil, err
+	}
+	if vestingSpend, found = k.GetVestingSpend(ctx, id); !found {
+		return sdk.NewCoins(), nil
+	}
+
+	unvested := vestingSpend.Unreleased()
+	if err := k.distributionKeeper.FundCommunityPool(ctx, unvested, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+		return nil, err
+	}
+	k.DeleteVestingSpend(ctx, id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClawbackVestingSpend,
+		sdk.NewAttribute(types.AttributeKeyVestingSpendID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, vestingSpend.Recipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, unvested.String()),
+	))
+
+	return unvested, nil
+}
+
+// GetVestingSpend returns the vesting spend with the given id.
+func (k Keeper) GetVestingSpend(ctx sdk.Context, id uint64) (types.VestingSpend, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.VestingSpendKey(id))
+	if bz == nil {
+		return types.VestingSpend{}, false
+	}
+
+	var vestingSpend types.VestingSpend
+	k.cdc.MustUnmarshal(bz, &vestingSpend)
+	return vestingSpend, true
+}
+
+// SetVestingSpend stores the vesting spend.
+func (k Keeper) SetVestingSpend(ctx sdk.Context, vestingSpend types.VestingSpend) {
+	ctx.KVStore(k.storeKey).Set(types.VestingSpendKey(vestingSpend.Id), k.cdc.MustMarshal(&vestingSpend))
+}
+
+// DeleteVestingSpend deletes the vesting spend with the given id.
+func (k Keeper) DeleteVestingSpend(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.VestingSpendKey(id))
+}
+
+// IterateVestingSpends iterates over the vesting spends in id order until cb
+// returns true.
+func (k Keeper) IterateVestingSpends(ctx sdk.Context, cb func(vestingSpend types.VestingSpend) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VestingSpendKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vestingSpend types.VestingSpend
+		k.cdc.MustUnmarshal(iterator.Value(), &vestingSpend)
+		if cb(vestingSpend) {
+			break
+		}
+	}
+}
+
+// GetNextVestingSpendID returns the id of the next vesting spend.
+func (k Keeper) GetNextVestingSpendID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextVestingSpendIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextVestingSpendID sets the id of the next vesting spend.
+func (k Keeper) SetNextVestingSpendID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextVestingSpendIDKey, sdk.Uint64ToBigEndian(id))
+}
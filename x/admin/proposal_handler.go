@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"github.com/CudoVentures/cudos-node/x/admin/keeper"
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewVestingSpendProposalHandler creates the governance handler for the
+// community pool vesting spend and clawback proposals.
+func NewVestingSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.CommunityPoolVestingSpendProposal:
+			recipient, err := sdk.AccAddressFromBech32(c.Recipient)
+			if err != nil {
+				return err
+			}
+			_, err = k.CreateVestingSpend(ctx, recipient, c.StartTime, c.Periods)
+			return err
+		case *types.ClawbackVestingSpendProposal:
+			_, err := k.ClawbackVestingSpend(ctx, c.VestingSpendId)
+			return err
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding admin type. The admin role itself is kept in the
+// bank balances, the store only holds the vesting spends.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.VestingSpendKeyPrefix):
+			var vestingSpendA, vestingSpendB types.VestingSpend
+			cdc.MustUnmarshal(kvA.Value, &vestingSpendA)
+			cdc.MustUnmarshal(kvB.Value, &vestingSpendB)
+			return fmt.Sprintf("%v\n%v", vestingSpendA, vestingSpendB)
+		case bytes.Equal(kvA.Key, types.NextVestingSpendIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		default:
+			panic(fmt.Sprintf("invalid admin key %X", kvA.Key))
+		}
+	}
+}
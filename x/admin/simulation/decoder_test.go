@@ -0,0 +1,32 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CudoVentures/cudos-node/app"
+	"github.com/CudoVentures/cudos-node/x/admin/simulation"
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	vestingSpend := types.NewVestingSpend(1, recipient, 1000, []types.VestingPeriod{{Length: 60, Amount: sdk.NewCoins(sdk.NewInt64Coin("acudos", 10))}})
+
+	kvPairs := kv.Pairs{Pairs: []kv.Pair{
+		{Key: types.VestingSpendKey(1), Value: cdc.MustMarshal(&vestingSpend)},
+		{Key: types.NextVestingSpendIDKey, Value: sdk.Uint64ToBigEndian(2)},
+		{Key: []byte{0x99}, Value: []byte{0x99}},
+	}}
+
+	require.Equal(t, fmt.Sprintf("%v\n%v", vestingSpend, vestingSpend), dec(kvPairs.Pairs[0], kvPairs.Pairs[0]))
+	require.Equal(t, "2\n2", dec(kvPairs.Pairs[1], kvPairs.Pairs[1]))
+	require.Panics(t, func() { dec(kvPairs.Pairs[2], kvPairs.Pairs[2]) })
+}
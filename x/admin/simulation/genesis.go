@@ -0,0 +1,67 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Simulation parameter constants
+const (
+	AdminAccounts = "admin_accounts"
+)
+
+// GenAdminAccounts randomized number of admin accounts, a chain has a few
+func GenAdminAccounts(r *rand.Rand) int {
+	return 1 + r.Intn(5)
+}
+
+// RandomizedGenState generates a random GenesisState for admin. The admin
+// role is a cudosAdmin balance, so it is granted in the bank genesis, which
+// has to be generated before.
+func RandomizedGenState(simState *module.SimulationState) {
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(types.DefaultGenesis())
+
+	bankGenStateBz, ok := simState.GenState[banktypes.ModuleName]
+	if !ok || len(simState.Accounts) == 0 {
+		return
+	}
+
+	var adminAccounts int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AdminAccounts, &adminAccounts, simState.Rand,
+		func(r *rand.Rand) { adminAccounts = GenAdminAccounts(r) },
+	)
+	if adminAccounts > len(simState.Accounts) {
+		adminAccounts = len(simState.Accounts)
+	}
+
+	var bankGenState banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(bankGenStateBz, &bankGenState)
+
+	adminRole := sdk.NewCoins(sdk.NewCoin(types.AdminDenom, sdk.OneInt()))
+	admins := make(map[string]bool)
+	for _, i := range simState.Rand.Perm(len(simState.Accounts))[:adminAccounts] {
+		admins[simState.Accounts[i].Address.String()] = true
+	}
+
+	for i, balance := range bankGenState.Balances {
+		if admins[balance.Address] {
+			bankGenState.Balances[i].Coins = balance.Coins.Add(adminRole...)
+			delete(admins, balance.Address)
+		}
+	}
+	for address := range admins {
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: address, Coins: adminRole})
+	}
+	bankGenState.Supply = bankGenState.Supply.Add(sdk.NewCoin(types.AdminDenom, sdk.NewInt(int64(adminAccounts))))
+
+	fmt.Printf("Selected %d randomly generated admin accounts\n", adminAccounts)
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenState)
+}
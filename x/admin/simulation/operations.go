@@ -0,0 +1,98 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/CudoVentures/cudos-node/x/admin/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAdminSpendCommunityPool = "op_weight_msg_admin_spend_community_pool"
+
+	DefaultWeightMsgAdminSpendCommunityPool = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, dk types.DistributionKeeper) simulation.WeightedOperations {
+	var weightMsgAdminSpendCommunityPool int
+	appParams.GetOrGenerate(cdc, OpWeightMsgAdminSpendCommunityPool, &weightMsgAdminSpendCommunityPool, nil,
+		func(_ *rand.Rand) {
+			weightMsgAdminSpendCommunityPool = DefaultWeightMsgAdminSpendCommunityPool
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgAdminSpendCommunityPool,
+			SimulateMsgAdminSpendCommunityPool(ak, bk, dk),
+		),
+	}
+}
+
+// SimulateMsgAdminSpendCommunityPool generates a MsgAdminSpendCommunityPool
+// of a random admin spending a random part of the community pool.
+func SimulateMsgAdminSpendCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, dk types.DistributionKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var admin simtypes.Account
+		var found bool
+		for _, i := range r.Perm(len(accs)) {
+			if bk.GetBalance(ctx, accs[i].Address, types.AdminDenom).IsPositive() {
+				admin, found = accs[i], true
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSend, "no admin account"), nil, nil
+		}
+
+		communityPool, _ := dk.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+		if communityPool.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSend, "community pool is empty"), nil, nil
+		}
+
+		coins := simtypes.RandSubsetCoins(r, communityPool)
+		if coins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSend, "spend amount is zero"), nil, nil
+		}
+
+		simToAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgAdminSpendCommunityPool(admin.Address, simToAccount.Address, coins)
+
+		// the fees must not take the admin role away
+		var spendable sdk.Coins
+		for _, coin := range bk.SpendableCoins(ctx, admin.Address) {
+			if coin.Denom != types.AdminDenom {
+				spendable = append(spendable, coin)
+			}
+		}
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    admin,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTx(txCtx, fees)
+	}
+}
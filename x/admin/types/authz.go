@@ -0,0 +1,98 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &AdminSpendAuthorization{}
+
+// NewAdminSpendAuthorization creates an authorization to spend up to
+// spendLimit of the community pool to the allowed recipients until the
+// expiration. No recipients allow any recipient and a nil expiration never
+// expires.
+func NewAdminSpendAuthorization(spendLimit sdk.Coins, allowedRecipients []sdk.AccAddress, expiration *time.Time) *AdminSpendAuthorization {
+	recipients := make([]string, len(allowedRecipients))
+	for i, recipient := range allowedRecipients {
+		recipients[i] = recipient.String()
+	}
+
+	return &AdminSpendAuthorization{
+		SpendLimit:        spendLimit,
+		AllowedRecipients: recipients,
+		Expiration:        expiration,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a AdminSpendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgAdminSpendCommunityPool{})
+}
+
+// Accept implements Authorization.Accept.
+func (a AdminSpendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	spend, ok := msg.(*MsgAdminSpendCommunityPool)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if a.Expiration != nil && !ctx.BlockTime().Before(*a.Expiration) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("authorization expired at %s", a.Expiration.Format(time.RFC3339))
+	}
+
+	if !a.isAllowedRecipient(spend.ToAddress) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("%s is not an allowed recipient", spend.ToAddress)
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(spend.Coins)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("requested amount is more than spend limit")
+	}
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &AdminSpendAuthorization{
+		SpendLimit:        limitLeft,
+		AllowedRecipients: a.AllowedRecipients,
+		Expiration:        a.Expiration,
+	}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a AdminSpendAuthorization) ValidateBasic() error {
+	if a.SpendLimit == nil {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be nil")
+	}
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit %s", a.SpendLimit)
+	}
+
+	seen := make(map[string]bool, len(a.AllowedRecipients))
+	for _, recipient := range a.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowed recipient (%s)", err)
+		}
+		if seen[recipient] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate allowed recipient %s", recipient)
+		}
+		seen[recipient] = true
+	}
+
+	return nil
+}
+
+func (a AdminSpendAuthorization) isAllowedRecipient(recipient string) bool {
+	if len(a.AllowedRecipients) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowedRecipients {
+		if allowed == recipient {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,470 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cudos/admin/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AdminSpendAuthorization allows the grantee to spend up to spend_limit coins
+// of the community pool on behalf of an admin, only to the allowed recipients
+// and only until the expiration.
+type AdminSpendAuthorization struct {
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// allowed_recipients are the addresses the grantee can spend to, any
+	// address if empty.
+	AllowedRecipients []string `protobuf:"bytes,2,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+	// expiration is the time after which the grantee can no longer spend, none
+	// if unset.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *AdminSpendAuthorization) Reset()         { *m = AdminSpendAuthorization{} }
+func (m *AdminSpendAuthorization) String() string { return proto.CompactTextString(m) }
+func (*AdminSpendAuthorization) ProtoMessage()    {}
+func (*AdminSpendAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef40d627f4e4782b, []int{0}
+}
+func (m *AdminSpendAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminSpendAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminSpendAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminSpendAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminSpendAuthorization.Merge(m, src)
+}
+func (m *AdminSpendAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminSpendAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminSpendAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminSpendAuthorization proto.InternalMessageInfo
+
+func (m *AdminSpendAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *AdminSpendAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+func (m *AdminSpendAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AdminSpendAuthorization)(nil), "cudosnode.cudosnode.pocbasecosmos.AdminSpendAuthorization")
+}
+
+func init() { proto.RegisterFile("cudos/admin/authz.proto", fileDescriptor_ef40d627f4e4782b) }
+
+var fileDescriptor_ef40d627f4e4782b = []byte{
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbd, 0x4e, 0xeb, 0x30,
+	0x14, 0xc7, 0x93, 0xf6, 0xea, 0x4a, 0x37, 0xd5, 0x1d, 0x1a, 0x21, 0xf5, 0x63, 0x48, 0x0a, 0x53,
+	0x96, 0xd8, 0x6d, 0xd9, 0x98, 0x68, 0xbb, 0x21, 0xa6, 0x80, 0x18, 0x58, 0xaa, 0x7c, 0x98, 0xd4,
+	0x22, 0xf1, 0x89, 0x62, 0x07, 0x4a, 0x5f, 0x81, 0xa5, 0xcf, 0xc1, 0xcc, 0x43, 0x74, 0xac, 0x98,
+	0x98, 0x28, 0x6a, 0x5f, 0x04, 0xc5, 0x71, 0xa1, 0x4c, 0x3e, 0xc7, 0xbf, 0xf3, 0xf1, 0xff, 0xeb,
+	0x18, 0xad, 0xb0, 0x88, 0x80, 0x63, 0x3f, 0x4a, 0x29, 0xc3, 0x7e, 0x21, 0x66, 0x0b, 0x94, 0xe5,
+	0x20, 0xc0, 0x3c, 0x96, 0x80, 0x41, 0x44, 0xd0, 0x4f, 0x94, 0x41, 0x18, 0xf8, 0x9c, 0x84, 0xc0,
+	0x53, 0xe0, 0xdd, 0xa3, 0x18, 0x62, 0x90, 0xd5, 0xb8, 0x8c, 0xaa, 0xc6, 0x6e, 0xa7, 0xa2, 0xd3,
+	0x0a, 0x54, 0x89, 0x42, 0x56, 0x95, 0xe1, 0x72, 0x06, 0x7e, 0x18, 0x04, 0x44, 0xf8, 0x03, 0x1c,
+	0x02, 0x65, 0x8a, 0xdb, 0x31, 0x40, 0x9c, 0x10, 0x2c, 0xb3, 0xa0, 0xb8, 0xc3, 0x82, 0xa6, 0x84,
+	0x0b, 0x3f, 0xcd, 0xaa, 0x82, 0x93, 0xe7, 0x9a, 0xd1, 0x1a, 0x95, 0x52, 0xaf, 0x32, 0xc2, 0xa2,
+	0x51, 0x21, 0x66, 0x90, 0xd3, 0x85, 0x2f, 0x28, 0x30, 0x33, 0x31, 0x1a, 0xbc, 0xfc, 0x9d, 0x26,
+	0x34, 0xa5, 0xa2, 0xad, 0xf7, 0xea, 0x4e, 0x63, 0xd8, 0x41, 0x4a, 0x40, 0xb9, 0x12, 0xa9, 0x95,
+	0x68, 0x02, 0x94, 0x8d, 0xfb, 0xab, 0x0f, 0x5b, 0x7b, 0xd9, 0xd8, 0x4e, 0x4c, 0xc5, 0xac, 0x08,
+	0x50, 0x08, 0xa9, 0x52, 0xab, 0x1e, 0x97, 0x47, 0xf7, 0x58, 0x3c, 0x65, 0x84, 0xcb, 0x06, 0xee,
+	0x19, 0x72, 0xfe, 0x65, 0x39, 0xde, 0x74, 0x0d, 0xd3, 0x4f, 0x12, 0x78, 0x24, 0xd1, 0x34, 0x27,
+	0x21, 0xcd, 0x28, 0x61, 0x82, 0xb7, 0x6b, 0xbd, 0xba, 0xf3, 0xcf, 0x6b, 0x2a, 0xe2, 0x7d, 0x03,
+	0xf3, 0xdc, 0x30, 0xc8, 0x3c, 0xa3, 0xb9, 0x94, 0xda, 0xae, 0xf7, 0x74, 0xa7, 0x31, 0xec, 0xa2,
+	0xca, 0x2e, 0xda, 0xdb, 0x45, 0xd7, 0x7b, 0xbb, 0xe3, 0x3f, 0xcb, 0x8d, 0xad, 0x7b, 0x07, 0x3d,
+	0x67, 0xcd, 0xb7, 0x57, 0xf7, 0xff, 0x2f, 0xc7, 0xe3, 0x8b, 0xd5, 0xd6, 0xd2, 0xd7, 0x5b, 0x4b,
+	0xff, 0xdc, 0x5a, 0xfa, 0x72, 0x67, 0x69, 0xeb, 0x9d, 0xa5, 0xbd, 0xef, 0x2c, 0xed, 0xb6, 0x7f,
+	0xe0, 0x69, 0x52, 0x44, 0x70, 0x43, 0x98, 0x28, 0x72, 0xc2, 0xb1, 0x3c, 0xa5, 0x5b, 0xde, 0x12,
+	0xcf, 0xd5, 0xd1, 0xa5, 0xc3, 0xe0, 0xaf, 0x14, 0x71, 0xfa, 0x35, 0x00, 0x73, 0x6a, 0xe1, 0x49,
+	0x10, 0x02, 0x00, 0x00,
+}
+
+func (m *AdminSpendAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminSpendAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSpendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AdminSpendAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AdminSpendAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminSpendAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminSpendAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestAdminSpendAuthorizationAccept(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	treasury := sdk.AccAddress("treasury____________")
+	other := sdk.AccAddress("other_______________")
+	now := time.Unix(1_700_000_000, 0).UTC()
+	expiration := now.Add(time.Hour)
+	ctx := sdk.NewContext(nil, tmproto.Header{Time: now}, false, nil)
+
+	authorization := NewAdminSpendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("acudos", 100)), []sdk.AccAddress{treasury}, &expiration)
+	require.NoError(t, authorization.ValidateBasic())
+
+	resp, err := authorization.Accept(ctx, NewMsgAdminSpendCommunityPool(admin, treasury, sdk.NewCoins(sdk.NewInt64Coin("acudos", 40))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*AdminSpendAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("acudos", 60)), updated.SpendLimit)
+	require.Equal(t, authorization.AllowedRecipients, updated.AllowedRecipients)
+	require.Equal(t, &expiration, updated.Expiration)
+
+	// spending the whole limit deletes the grant
+	resp, err = updated.Accept(ctx, NewMsgAdminSpendCommunityPool(admin, treasury, sdk.NewCoins(sdk.NewInt64Coin("acudos", 60))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	_, err = authorization.Accept(ctx, NewMsgAdminSpendCommunityPool(admin, treasury, sdk.NewCoins(sdk.NewInt64Coin("acudos", 101))))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	_, err = authorization.Accept(ctx, NewMsgAdminSpendCommunityPool(admin, treasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	_, err = authorization.Accept(ctx, NewMsgAdminSpendCommunityPool(admin, other, sdk.NewCoins(sdk.NewInt64Coin("acudos", 1))))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = authorization.Accept(ctx.WithBlockTime(expiration), NewMsgAdminSpendCommunityPool(admin, treasury, sdk.NewCoins(sdk.NewInt64Coin("acudos", 1))))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// no recipients and no expiration allow any recipient at any time
+	unbounded := NewAdminSpendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("acudos", 100)), nil, nil)
+	resp, err = unbounded.Accept(ctx.WithBlockTime(expiration), NewMsgAdminSpendCommunityPool(admin, other, sdk.NewCoins(sdk.NewInt64Coin("acudos", 1))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+}
+
+func TestAdminSpendAuthorizationValidateBasic(t *testing.T) {
+	treasury := sdk.AccAddress("treasury____________")
+
+	authorization := NewAdminSpendAuthorization(nil, nil, nil)
+	require.Error(t, authorization.ValidateBasic())
+
+	authorization = NewAdminSpendAuthorization(sdk.Coins{sdk.Coin{Denom: "acudos", Amount: sdk.ZeroInt()}}, nil, nil)
+	require.Error(t, authorization.ValidateBasic())
+
+	authorization = NewAdminSpendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("acudos", 1)), []sdk.AccAddress{treasury, treasury}, nil)
+	require.Error(t, authorization.ValidateBasic())
+
+	authorization = NewAdminSpendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("acudos", 1)), nil, nil)
+	authorization.AllowedRecipients = []string{"invalid"}
+	require.Error(t, authorization.ValidateBasic())
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	// this line is used by starport scaffolding # 1
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
@@ -11,6 +13,9 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	// this line is used by starport scaffolding # 2
 	cdc.RegisterConcrete(&MsgAdminSpendCommunityPool{}, "admin/AdminSpendCommunityPool", nil)
+	cdc.RegisterConcrete(&AdminSpendAuthorization{}, "admin/AdminSpendAuthorization", nil)
+	cdc.RegisterConcrete(&CommunityPoolVestingSpendProposal{}, "admin/CommunityPoolVestingSpendProposal", nil)
+	cdc.RegisterConcrete(&ClawbackVestingSpendProposal{}, "admin/ClawbackVestingSpendProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -19,6 +24,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgAdminSpendCommunityPool{},
 	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&AdminSpendAuthorization{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&CommunityPoolVestingSpendProposal{},
+		&ClawbackVestingSpendProposal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -27,3 +41,8 @@ var (
 	amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewAminoCodec(amino)
 )
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
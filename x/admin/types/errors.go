@@ -8,5 +8,9 @@ import (
 
 // x/admin module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample                    = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrInvalidVestingSpend       = sdkerrors.Register(ModuleName, 1101, "invalid vesting spend")
+	ErrVestingSpendNotFound      = sdkerrors.Register(ModuleName, 1102, "vesting spend not found")
+	ErrInsufficientCommunityPool = sdkerrors.Register(ModuleName, 1103, "insufficient community pool funds")
+	ErrInvalidGenesis            = sdkerrors.Register(ModuleName, 1104, "invalid genesis")
 )
@@ -0,0 +1,12 @@
+package types
+
+// admin module event types
+const (
+	EventTypeCreateVestingSpend   = "create_vesting_spend"
+	EventTypeReleaseVestingSpend  = "release_vesting_spend"
+	EventTypeClawbackVestingSpend = "clawback_vesting_spend"
+
+	AttributeKeyVestingSpendID = "vesting_spend_id"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyAmount         = "amount"
+)
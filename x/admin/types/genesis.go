@@ -1,22 +1,43 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
-// DefaultGenesis returns the default Capability genesis state
-func DefaultGenesis() *GenesisState {
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(vestingSpends []VestingSpend, nextVestingSpendID uint64) *GenesisState {
 	return &GenesisState{
-		// this line is used by starport scaffolding # genesis/types/default
+		VestingSpends:      vestingSpends,
+		NextVestingSpendId: nextVestingSpendID,
 	}
 }
 
+// DefaultGenesis returns the default Capability genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState([]VestingSpend{}, 1)
+}
+
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. A zero next vesting spend id is left from before vesting spends
+// existed and stands for the first id.
 func (gs GenesisState) Validate() error {
+	ids := make(map[uint64]bool, len(gs.VestingSpends))
+	for _, vestingSpend := range gs.VestingSpends {
+		if err := vestingSpend.Validate(); err != nil {
+			return err
+		}
+		if vestingSpend.Id == 0 || vestingSpend.Id >= gs.NextVestingSpendId {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "vesting spend id %d must be between 1 and the next vesting spend id %d", vestingSpend.Id, gs.NextVestingSpendId)
+		}
+		if ids[vestingSpend.Id] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate vesting spend %d", vestingSpend.Id)
+		}
+		ids[vestingSpend.Id] = true
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return nil
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -24,6 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the capability module's genesis state.
 type GenesisState struct {
+	VestingSpends      []VestingSpend `protobuf:"bytes,1,rep,name=vesting_spends,json=vestingSpends,proto3" json:"vesting_spends"`
+	NextVestingSpendId uint64         `protobuf:"varint,2,opt,name=next_vesting_spend_id,json=nextVestingSpendId,proto3" json:"next_vesting_spend_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -59,6 +62,20 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetVestingSpends() []VestingSpend {
+	if m != nil {
+		return m.VestingSpends
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextVestingSpendId() uint64 {
+	if m != nil {
+		return m.NextVestingSpendId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cudosnode.cudosnode.admin.GenesisState")
 }
@@ -66,17 +83,23 @@ func init() {
 func init() { proto.RegisterFile("cudos/admin/genesis.proto", fileDescriptor_a6306bedbcb57945) }
 
 var fileDescriptor_a6306bedbcb57945 = []byte{
-	// 148 bytes of a gzipped FileDescriptorProto
+	// 243 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x2e, 0x4d, 0xc9,
 	0x2f, 0xd6, 0x4f, 0x4c, 0xc9, 0xcd, 0xcc, 0xd3, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
 	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x82, 0x48, 0xe5, 0xe5, 0xa7, 0xa4, 0xea, 0x21, 0x58, 0x60,
-	0x85, 0x4a, 0x7c, 0x5c, 0x3c, 0xee, 0x10, 0xb5, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x4e, 0x5e, 0x27,
-	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
-	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a,
-	0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0x5c, 0x9a, 0x92, 0x1f, 0x96, 0x9a, 0x57, 0x52, 0x5a, 0x94,
-	0x5a, 0xac, 0x0f, 0x36, 0x52, 0x17, 0x64, 0xa6, 0x7e, 0x05, 0xd4, 0xfa, 0x92, 0xca, 0x82, 0xd4,
-	0xe2, 0x24, 0x36, 0xb0, 0xed, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x12, 0x8c, 0xe1, 0x07,
-	0x9a, 0x00, 0x00, 0x00,
+	0x85, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x55, 0xfa, 0x20, 0x16, 0x44, 0x83, 0x14, 0x8a,
+	0x59, 0x65, 0xa9, 0xc5, 0x25, 0x99, 0x79, 0xe9, 0x10, 0x29, 0xa5, 0xe9, 0x8c, 0x5c, 0x3c, 0xee,
+	0x10, 0xd3, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x42, 0xb8, 0xf8, 0xa0, 0x2a, 0xe2, 0x8b, 0x0b,
+	0x52, 0xf3, 0x52, 0x8a, 0x25, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0xd4, 0xf5, 0x70, 0xda, 0xaa,
+	0x17, 0x06, 0xd1, 0x10, 0x0c, 0x52, 0xef, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x6f, 0x19,
+	0x92, 0x58, 0xb1, 0x90, 0x21, 0x97, 0x68, 0x5e, 0x6a, 0x45, 0x49, 0x3c, 0x8a, 0xd1, 0xf1, 0x99,
+	0x29, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x42, 0x20, 0x49, 0x64, 0x53, 0x3c, 0x53, 0x9c,
+	0xbc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
+	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24,
+	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0xb9, 0x34, 0x25, 0x3f, 0x2c, 0x35, 0xaf, 0xa4,
+	0xb4, 0x28, 0xb5, 0x58, 0x1f, 0xec, 0x2e, 0x5d, 0x90, 0xc3, 0xf4, 0x2b, 0xa0, 0xbe, 0x2d, 0xa9,
+	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xd6, 0x18, 0x30, 0x00, 0x60, 0xde, 0xa6, 0xa8, 0x55,
+	0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -99,6 +122,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextVestingSpendId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextVestingSpendId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VestingSpends) > 0 {
+		for iNdEx := len(m.VestingSpends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSpends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +161,15 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.VestingSpends) > 0 {
+		for _, e := range m.VestingSpends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextVestingSpendId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextVestingSpendId))
+	}
 	return n
 }
 
@@ -157,6 +208,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSpends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSpends = append(m.VestingSpends, VestingSpend{})
+			if err := m.VestingSpends[len(m.VestingSpends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVestingSpendId", wireType)
+			}
+			m.NextVestingSpendId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVestingSpendId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "admin"
@@ -19,6 +23,20 @@ const (
 	AdminDenom = "cudosAdmin"
 )
 
+var (
+	// VestingSpendKeyPrefix prefixes the vesting spends, stored under
+	// VestingSpendKeyPrefix | big endian id.
+	VestingSpendKeyPrefix = []byte{0x01}
+
+	// NextVestingSpendIDKey stores the id of the next vesting spend.
+	NextVestingSpendIDKey = []byte{0x02}
+)
+
+// VestingSpendKey returns the key of the vesting spend with the given id.
+func VestingSpendKey(id uint64) []byte {
+	return append(append([]byte{}, VestingSpendKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
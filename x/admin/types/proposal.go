@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeCommunityPoolVestingSpend defines the type for a CommunityPoolVestingSpendProposal
+	ProposalTypeCommunityPoolVestingSpend = "CommunityPoolVestingSpend"
+	// ProposalTypeClawbackVestingSpend defines the type for a ClawbackVestingSpendProposal
+	ProposalTypeClawbackVestingSpend = "ClawbackVestingSpend"
+)
+
+var (
+	_ govtypes.Content = &CommunityPoolVestingSpendProposal{}
+	_ govtypes.Content = &ClawbackVestingSpendProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolVestingSpend)
+	govtypes.RegisterProposalTypeCodec(&CommunityPoolVestingSpendProposal{}, "admin/CommunityPoolVestingSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeClawbackVestingSpend)
+	govtypes.RegisterProposalTypeCodec(&ClawbackVestingSpendProposal{}, "admin/ClawbackVestingSpendProposal")
+}
+
+// NewCommunityPoolVestingSpendProposal creates a new community pool vesting spend proposal.
+func NewCommunityPoolVestingSpendProposal(title, description string, recipient sdk.AccAddress, startTime int64, periods []VestingPeriod) *CommunityPoolVestingSpendProposal {
+	return &CommunityPoolVestingSpendProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient.String(),
+		StartTime:   startTime,
+		Periods:     periods,
+	}
+}
+
+// GetTitle returns the title of a community pool vesting spend proposal.
+func (p *CommunityPoolVestingSpendProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a community pool vesting spend proposal.
+func (p *CommunityPoolVestingSpendProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a community pool vesting spend proposal.
+func (p *CommunityPoolVestingSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool vesting spend proposal.
+func (p *CommunityPoolVestingSpendProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolVestingSpend
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CommunityPoolVestingSpendProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if p.StartTime < 0 {
+		return sdkerrors.Wrap(ErrInvalidVestingSpend, "the start time must not be negative")
+	}
+	return ValidateVestingPeriods(p.Periods)
+}
+
+// String implements the Stringer interface.
+func (p CommunityPoolVestingSpendProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Vesting Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Start Time:  %d
+  Periods:
+`, p.Title, p.Description, p.Recipient, p.StartTime))
+	for _, period := range p.Periods {
+		b.WriteString(fmt.Sprintf("    %s after %ds\n", period.Amount, period.Length))
+	}
+	return b.String()
+}
+
+// NewClawbackVestingSpendProposal creates a new clawback vesting spend proposal.
+func NewClawbackVestingSpendProposal(title, description string, vestingSpendID uint64) *ClawbackVestingSpendProposal {
+	return &ClawbackVestingSpendProposal{
+		Title:          title,
+		Description:    description,
+		VestingSpendId: vestingSpendID,
+	}
+}
+
+// GetTitle returns the title of a clawback vesting spend proposal.
+func (p *ClawbackVestingSpendProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a clawback vesting spend proposal.
+func (p *ClawbackVestingSpendProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a clawback vesting spend proposal.
+func (p *ClawbackVestingSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a clawback vesting spend proposal.
+func (p *ClawbackVestingSpendProposal) ProposalType() string {
+	return ProposalTypeClawbackVestingSpend
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ClawbackVestingSpendProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.VestingSpendId == 0 {
+		return sdkerrors.Wrap(ErrInvalidVestingSpend, "the vesting spend id must be positive")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ClawbackVestingSpendProposal) String() string {
+	return fmt.Sprintf(`Clawback Vesting Spend Proposal:
+  Title:            %s
+  Description:      %s
+  Vesting Spend ID: %d
+`, p.Title, p.Description, p.VestingSpendId)
+}
@@ -1,17 +1,22 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: admin/query.proto
+// source: cudos/admin/query.proto
 
 package types
 
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -25,22 +30,229 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-func init() { proto.RegisterFile("admin/query.proto", fileDescriptor_51ad29062d7354f7) }
+type QueryVestingSpendRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryVestingSpendRequest) Reset()         { *m = QueryVestingSpendRequest{} }
+func (m *QueryVestingSpendRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingSpendRequest) ProtoMessage()    {}
+func (*QueryVestingSpendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09cbb5e26ca58c5c, []int{0}
+}
+func (m *QueryVestingSpendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingSpendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingSpendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingSpendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingSpendRequest.Merge(m, src)
+}
+func (m *QueryVestingSpendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingSpendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingSpendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingSpendRequest proto.InternalMessageInfo
+
+func (m *QueryVestingSpendRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryVestingSpendResponse struct {
+	VestingSpend VestingSpend `protobuf:"bytes,1,opt,name=vesting_spend,json=vestingSpend,proto3" json:"vesting_spend"`
+}
+
+func (m *QueryVestingSpendResponse) Reset()         { *m = QueryVestingSpendResponse{} }
+func (m *QueryVestingSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingSpendResponse) ProtoMessage()    {}
+func (*QueryVestingSpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09cbb5e26ca58c5c, []int{1}
+}
+func (m *QueryVestingSpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingSpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingSpendResponse.Merge(m, src)
+}
+func (m *QueryVestingSpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingSpendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingSpendResponse proto.InternalMessageInfo
 
-var fileDescriptor_51ad29062d7354f7 = []byte{
-	// 190 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xc8, 0x4f, 0x4e,
-	0x4a, 0x2c, 0x4e, 0x4d, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x2c, 0x4a, 0x29, 0xc8, 0x4b, 0xd1, 0x43, 0x51, 0x80,
-	0xca, 0x93, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc,
-	0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0x18, 0x20, 0xa5, 0x05, 0x35, 0x14,
-	0xa4, 0x01, 0x62, 0xb2, 0x7e, 0x99, 0x61, 0x52, 0x6a, 0x49, 0xa2, 0xa1, 0x7e, 0x41, 0x62, 0x7a,
-	0x66, 0x1e, 0x58, 0x31, 0x44, 0xad, 0x11, 0x3b, 0x17, 0x6b, 0x20, 0x48, 0x85, 0x53, 0xc0, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0x9d, 0xa6, 0x5f, 0x90, 0x9f, 0xac, 0x0b, 0x32, 0x5c, 0x17,
-	0x6a, 0x51, 0x85, 0x3e, 0xaa, 0x6f, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x36, 0x18,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x80, 0xab, 0x43, 0xeb, 0xeb, 0x00, 0x00, 0x00,
+func (m *QueryVestingSpendResponse) GetVestingSpend() VestingSpend {
+	if m != nil {
+		return m.VestingSpend
+	}
+	return VestingSpend{}
+}
+
+type QueryVestingSpendsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingSpendsRequest) Reset()         { *m = QueryVestingSpendsRequest{} }
+func (m *QueryVestingSpendsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingSpendsRequest) ProtoMessage()    {}
+func (*QueryVestingSpendsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09cbb5e26ca58c5c, []int{2}
+}
+func (m *QueryVestingSpendsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingSpendsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingSpendsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingSpendsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingSpendsRequest.Merge(m, src)
+}
+func (m *QueryVestingSpendsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingSpendsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingSpendsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingSpendsRequest proto.InternalMessageInfo
+
+func (m *QueryVestingSpendsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVestingSpendsResponse struct {
+	VestingSpends []VestingSpend      `protobuf:"bytes,1,rep,name=vesting_spends,json=vestingSpends,proto3" json:"vesting_spends"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingSpendsResponse) Reset()         { *m = QueryVestingSpendsResponse{} }
+func (m *QueryVestingSpendsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingSpendsResponse) ProtoMessage()    {}
+func (*QueryVestingSpendsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09cbb5e26ca58c5c, []int{3}
+}
+func (m *QueryVestingSpendsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingSpendsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingSpendsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingSpendsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingSpendsResponse.Merge(m, src)
+}
+func (m *QueryVestingSpendsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingSpendsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingSpendsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingSpendsResponse proto.InternalMessageInfo
+
+func (m *QueryVestingSpendsResponse) GetVestingSpends() []VestingSpend {
+	if m != nil {
+		return m.VestingSpends
+	}
+	return nil
+}
+
+func (m *QueryVestingSpendsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryVestingSpendRequest)(nil), "cudosnode.cudosnode.admin.QueryVestingSpendRequest")
+	proto.RegisterType((*QueryVestingSpendResponse)(nil), "cudosnode.cudosnode.admin.QueryVestingSpendResponse")
+	proto.RegisterType((*QueryVestingSpendsRequest)(nil), "cudosnode.cudosnode.admin.QueryVestingSpendsRequest")
+	proto.RegisterType((*QueryVestingSpendsResponse)(nil), "cudosnode.cudosnode.admin.QueryVestingSpendsResponse")
+}
+
+func init() { proto.RegisterFile("cudos/admin/query.proto", fileDescriptor_09cbb5e26ca58c5c) }
+
+var fileDescriptor_09cbb5e26ca58c5c = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6a, 0x14, 0x31,
+	0x18, 0xc7, 0x37, 0x63, 0xf5, 0x10, 0xbb, 0x3d, 0x04, 0xc1, 0xdd, 0x51, 0xc7, 0x65, 0x04, 0x2d,
+	0x05, 0x13, 0xdb, 0xea, 0x0b, 0x54, 0x50, 0xf0, 0xa4, 0xa3, 0xf4, 0xe0, 0x45, 0xb2, 0x9b, 0x10,
+	0x03, 0x6e, 0xbe, 0xe9, 0x26, 0xb3, 0x58, 0xc4, 0x8b, 0x4f, 0x20, 0xf8, 0x04, 0xe2, 0x63, 0xf8,
+	0x02, 0x3d, 0x56, 0xbc, 0x78, 0x12, 0xd9, 0xf5, 0x41, 0x64, 0x92, 0x2c, 0x9d, 0x85, 0x69, 0x65,
+	0x6e, 0x21, 0xdf, 0xf7, 0xff, 0xbe, 0xdf, 0xff, 0x9f, 0x19, 0x7c, 0x7d, 0x52, 0x09, 0xb0, 0x8c,
+	0x8b, 0xa9, 0x36, 0xec, 0xa8, 0x92, 0xb3, 0x63, 0x5a, 0xce, 0xc0, 0x01, 0x19, 0xfa, 0x82, 0x01,
+	0x21, 0xe9, 0xd9, 0xc9, 0xb7, 0xa5, 0xd7, 0x14, 0x28, 0xf0, 0x5d, 0xac, 0x3e, 0x05, 0x41, 0x7a,
+	0x53, 0x01, 0xa8, 0x77, 0x92, 0xf1, 0x52, 0x33, 0x6e, 0x0c, 0x38, 0xee, 0x34, 0x18, 0x1b, 0xab,
+	0x3b, 0x13, 0xb0, 0x53, 0xb0, 0x6c, 0xcc, 0xad, 0x0c, 0x7b, 0xd8, 0x7c, 0x77, 0x2c, 0x1d, 0xdf,
+	0x65, 0x25, 0x57, 0xda, 0xf8, 0xe6, 0xd8, 0x3b, 0x6c, 0x32, 0xcd, 0xa5, 0x75, 0xda, 0xa8, 0x50,
+	0xca, 0x77, 0xf0, 0xe0, 0x45, 0x2d, 0x3e, 0x0c, 0xb7, 0x2f, 0x4b, 0x69, 0x44, 0x21, 0x8f, 0x2a,
+	0x69, 0x1d, 0xd9, 0xc2, 0x89, 0x16, 0x03, 0x34, 0x42, 0xdb, 0x1b, 0x45, 0xa2, 0x45, 0x0e, 0x78,
+	0xd8, 0xd2, 0x6b, 0x4b, 0x30, 0x56, 0x92, 0x02, 0xf7, 0xe3, 0xe4, 0x37, 0xb6, 0x2e, 0x78, 0xdd,
+	0xd5, 0xbd, 0x7b, 0xf4, 0x5c, 0xdb, 0xb4, 0x39, 0xe7, 0x60, 0xe3, 0xe4, 0xf7, 0xed, 0x5e, 0xb1,
+	0x39, 0x6f, 0xdc, 0xe5, 0x93, 0x96, 0x85, 0x76, 0x45, 0xf7, 0x04, 0xe3, 0x33, 0xa3, 0x71, 0xdb,
+	0x5d, 0x1a, 0x52, 0xa1, 0x75, 0x2a, 0x34, 0xa4, 0x1f, 0x53, 0xa1, 0xcf, 0xb9, 0x92, 0x51, 0x5b,
+	0x34, 0x94, 0xf9, 0x77, 0x84, 0xd3, 0xb6, 0x2d, 0xd1, 0xd7, 0x2b, 0xbc, 0xb5, 0xe6, 0xcb, 0x0e,
+	0xd0, 0xe8, 0x52, 0x77, 0x63, 0xfd, 0xa6, 0x31, 0x4b, 0x9e, 0xae, 0xc1, 0x27, 0xab, 0xa8, 0xfe,
+	0x03, 0x1f, 0x90, 0x9a, 0xf4, 0x7b, 0x3f, 0x12, 0x7c, 0xd9, 0xd3, 0x93, 0x6f, 0x08, 0x6f, 0x36,
+	0x17, 0x93, 0xfd, 0x0b, 0x08, 0xcf, 0x7b, 0xf3, 0xf4, 0x61, 0x37, 0x51, 0x20, 0xca, 0xb7, 0x3f,
+	0xfd, 0xfc, 0xfb, 0x25, 0xc9, 0xc9, 0x88, 0xb5, 0x7c, 0x69, 0x31, 0x37, 0xf6, 0x41, 0x8b, 0x8f,
+	0xe4, 0x2b, 0xc2, 0xfd, 0xb5, 0xa0, 0x49, 0xa7, 0x8d, 0xab, 0xd7, 0x4f, 0x1f, 0x75, 0x54, 0x45,
+	0xd0, 0x3b, 0x1e, 0xf4, 0x16, 0xb9, 0x71, 0x01, 0xe8, 0xc1, 0xb3, 0x93, 0x45, 0x86, 0x4e, 0x17,
+	0x19, 0xfa, 0xb3, 0xc8, 0xd0, 0xe7, 0x65, 0xd6, 0x3b, 0x5d, 0x66, 0xbd, 0x5f, 0xcb, 0xac, 0xf7,
+	0xfa, 0x81, 0xd2, 0xee, 0x6d, 0x35, 0xa6, 0x13, 0x98, 0xb2, 0xc7, 0x95, 0x80, 0x43, 0x69, 0x5c,
+	0x35, 0x93, 0x36, 0x4c, 0xbb, 0x5f, 0x33, 0xb0, 0xf7, 0x71, 0xa8, 0x3b, 0x2e, 0xa5, 0x1d, 0x5f,
+	0xf1, 0xbf, 0xd9, 0xfe, 0xbf, 0x01, 0x00, 0x47, 0x8d, 0x47, 0xa7, 0x17, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -55,6 +267,10 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// VestingSpend queries a vesting spend.
+	VestingSpend(ctx context.Context, in *QueryVestingSpendRequest, opts ...grpc.CallOption) (*QueryVestingSpendResponse, error)
+	// VestingSpends queries all vesting spends.
+	VestingSpends(ctx context.Context, in *QueryVestingSpendsRequest, opts ...grpc.CallOption) (*QueryVestingSpendsResponse, error)
 }
 
 type queryClient struct {
@@ -65,22 +281,768 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) VestingSpend(ctx context.Context, in *QueryVestingSpendRequest, opts ...grpc.CallOption) (*QueryVestingSpendResponse, error) {
+	out := new(QueryVestingSpendResponse)
+	err := c.cc.Invoke(ctx, "/cudosnode.cudosnode.admin.Query/VestingSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingSpends(ctx context.Context, in *QueryVestingSpendsRequest, opts ...grpc.CallOption) (*QueryVestingSpendsResponse, error) {
+	out := new(QueryVestingSpendsResponse)
+	err := c.cc.Invoke(ctx, "/cudosnode.cudosnode.admin.Query/VestingSpends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// VestingSpend queries a vesting spend.
+	VestingSpend(context.Context, *QueryVestingSpendRequest) (*QueryVestingSpendResponse, error)
+	// VestingSpends queries all vesting spends.
+	VestingSpends(context.Context, *QueryVestingSpendsRequest) (*QueryVestingSpendsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) VestingSpend(ctx context.Context, req *QueryVestingSpendRequest) (*QueryVestingSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingSpend not implemented")
+}
+func (*UnimplementedQueryServer) VestingSpends(ctx context.Context, req *QueryVestingSpendsRequest) (*QueryVestingSpendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingSpends not implemented")
+}
+
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_VestingSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cudosnode.cudosnode.admin.Query/VestingSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingSpend(ctx, req.(*QueryVestingSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingSpends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingSpendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingSpends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cudosnode.cudosnode.admin.Query/VestingSpends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingSpends(ctx, req.(*QueryVestingSpendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rdpnd.admin.admin.Query",
+	ServiceName: "cudosnode.cudosnode.admin.Query",
 	HandlerType: (*QueryServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "admin/query.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VestingSpend",
+			Handler:    _Query_VestingSpend_Handler,
+		},
+		{
+			MethodName: "VestingSpends",
+			Handler:    _Query_VestingSpends_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cudos/admin/query.proto",
+}
+
+func (m *QueryVestingSpendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingSpendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingSpendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VestingSpend.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingSpendsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingSpendsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingSpendsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingSpendsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
+
+func (m *QueryVestingSpendsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingSpendsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VestingSpends) > 0 {
+		for iNdEx := len(m.VestingSpends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSpends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVestingSpendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryVestingSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VestingSpend.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingSpendsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingSpendsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VestingSpends) > 0 {
+		for _, e := range m.VestingSpends {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVestingSpendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingSpendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingSpendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingSpendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingSpendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingSpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingSpendsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingSpendsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingSpendsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingSpendsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingSpendsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingSpendsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSpends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSpends = append(m.VestingSpends, VestingSpend{})
+			if err := m.VestingSpends[len(m.VestingSpends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewVestingSpend creates a new vesting spend that released nothing yet.
+func NewVestingSpend(id uint64, recipient sdk.AccAddress, startTime int64, periods []VestingPeriod) VestingSpend {
+	return VestingSpend{
+		Id:        id,
+		Recipient: recipient.String(),
+		StartTime: startTime,
+		Periods:   periods,
+		Released:  sdk.NewCoins(),
+	}
+}
+
+// ValidateVestingPeriods checks that the schedule has periods of positive
+// length and amount.
+func ValidateVestingPeriods(periods []VestingPeriod) error {
+	if len(periods) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVestingSpend, "the schedule has no periods")
+	}
+	for i, period := range periods {
+		if period.Length <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidVestingSpend, "period %d must have a positive length", i)
+		}
+		if !period.Amount.IsValid() || period.Amount.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidVestingSpend, "period %d must have a positive amount, got %s", i, period.Amount)
+		}
+	}
+	return nil
+}
+
+// VestingPeriodsAmount returns the sum of the amounts of the periods.
+func VestingPeriodsAmount(periods []VestingPeriod) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range periods {
+		total = total.Add(period.Amount...)
+	}
+	return total
+}
+
+// Validate performs a basic validation of the vesting spend.
+func (vs VestingSpend) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(vs.Recipient); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidVestingSpend, "invalid recipient of vesting spend %d: %s", vs.Id, err)
+	}
+	if vs.StartTime <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidVestingSpend, "vesting spend %d must have a positive start time", vs.Id)
+	}
+	if err := ValidateVestingPeriods(vs.Periods); err != nil {
+		return sdkerrors.Wrapf(err, "vesting spend %d", vs.Id)
+	}
+	if !vs.Released.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidVestingSpend, "invalid released amount %s of vesting spend %d", vs.Released, vs.Id)
+	}
+	if !vs.Released.IsAllLTE(vs.Amount()) || vs.Released.IsEqual(vs.Amount()) {
+		return sdkerrors.Wrapf(ErrInvalidVestingSpend, "vesting spend %d released %s of %s", vs.Id, vs.Released, vs.Amount())
+	}
+	return nil
+}
+
+// Amount returns the total amount of the vesting spend.
+func (vs VestingSpend) Amount() sdk.Coins {
+	return VestingPeriodsAmount(vs.Periods)
+}
+
+// VestedCoins returns the amount unlocked by the periods ended at blockTime.
+func (vs VestingSpend) VestedCoins(blockTime time.Time) sdk.Coins {
+	vested := sdk.NewCoins()
+	end := vs.StartTime
+	for _, period := range vs.Periods {
+		end += period.Length
+		if blockTime.Unix() < end {
+			break
+		}
+		vested = vested.Add(period.Amount...)
+	}
+	return vested
+}
+
+// Releasable returns the vested amount that was not released yet.
+func (vs VestingSpend) Releasable(blockTime time.Time) sdk.Coins {
+	releasable, hasNeg := vs.VestedCoins(blockTime).SafeSub(vs.Released)
+	if hasNeg {
+		return sdk.NewCoins()
+	}
+	return releasable
+}
+
+// Unreleased returns the amount that was not released yet.
+func (vs VestingSpend) Unreleased() sdk.Coins {
+	return vs.Amount().Sub(vs.Released)
+}
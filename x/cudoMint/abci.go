@@ -33,7 +33,7 @@ minter.NormTimePassed holds the current step as accumulator. Each block it is in
 var (
 	// based on the assumption that we have 1 block per 5 seconds
 	// if actual blocks are generated at slower rate then the network will mint tokens more than 3652 days (~10 years)
-	denom                 = "acudos"         // Hardcoded to the acudos currency. Its not changeable, because some of the math depends on the size of this denomination
+	denom                 = types.MintDenom  // Hardcoded to the acudos currency. Its not changeable, because some of the math depends on the size of this denomination
 	totalDays             = sdk.NewInt(3652) // Hardcoded to 10 years
 	InitialNormTimePassed = sdk.NewDecWithPrec(53172694105988, 14)
 	FinalNormTimePassed   = sdk.NewDec(10)
@@ -73,13 +73,43 @@ func calculateMintedCoins(minter types.Minter, increment sdk.Dec) sdk.Dec {
 	return (nextStep.Sub(prevStep)).Mul(sdk.NewDec(10).Power(24)) // formula calculates in mil of cudos + converting to acudos
 }
 
+// applyStakingFeedback returns the amount to mint instead of the scheduled
+// amount, and the carry left afterwards. A factor below one holds back part of
+// the scheduled amount in the carry, a factor above one mints more than
+// scheduled out of the carry only, so the total emission stays the same.
+// Every block also mints an even share of the carry over the remaining
+// blocks of the emission, so that nothing is left after the last one without
+// minting the carry at once.
+func applyStakingFeedback(scheduled, carry sdk.Int, factor sdk.Dec, remainingBlocks int64) (sdk.Int, sdk.Int) {
+	available := scheduled.Add(carry)
+	minted := factor.MulInt(scheduled).TruncateInt()
+	if remainingBlocks <= 1 {
+		minted = available
+	} else {
+		minted = minted.Add(carry.QuoRaw(remainingBlocks))
+	}
+	if minted.GT(available) {
+		minted = available
+	}
+	return minted, available.Sub(minted)
+}
+
+// remainingBlocks returns how many blocks still mint, including the current
+// one, once the minter reached normTimePassed.
+func remainingBlocks(normTimePassed, incr sdk.Dec) int64 {
+	if normTimePassed.Add(incr).GT(FinalNormTimePassed) {
+		return 1
+	}
+	return FinalNormTimePassed.Sub(normTimePassed).Quo(incr).TruncateInt64() + 1
+}
+
 func logMintingInfo(ctx sdk.Context, k keeper.Keeper, minter types.Minter) {
 	initiallySkipped := calculateIntegral(InitialNormTimePassed)
 	mintedSoFar := calculateIntegral(sdk.MinDec(minter.NormTimePassed, FinalNormTimePassed))
-	mintedSoFar = mintedSoFar.Sub(initiallySkipped).Mul(sdk.NewDec(10).Power(24))
+	mintedSoFar = mintedSoFar.Sub(initiallySkipped).Mul(sdk.NewDec(10).Power(24)).Sub(minter.EmissionCarry.ToDec())
 	total := calculateIntegral(FinalNormTimePassed)
 	total = total.Sub(initiallySkipped).Mul(sdk.NewDec(10).Power(24))
-	k.Logger(ctx).Info("CudosMint module", "minted_so_far", mintedSoFar.TruncateInt().String()+denom, "left", total.Sub(mintedSoFar).TruncateInt().String()+denom, "total", total.TruncateInt().String()+denom)
+	k.Logger(ctx).Info("CudosMint module", "minted_so_far", mintedSoFar.TruncateInt().String()+denom, "left", total.Sub(mintedSoFar).TruncateInt().String()+denom, "total", total.TruncateInt().String()+denom, "carried", minter.EmissionCarry.String()+denom)
 }
 
 // BeginBlocker mints new tokens for the previous block.
@@ -93,8 +123,15 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	incr := normalizeBlockHeightInc(params.IncrementModifier)
-	mintAmountDec := calculateMintedCoins(minter, incr)
-	mintAmountInt := mintAmountDec.TruncateInt()
+	scheduledAmountDec := calculateMintedCoins(minter, incr)
+	scheduledAmount := scheduledAmountDec.TruncateInt()
+
+	factor := sdk.OneDec()
+	if params.StakingFeedbackEnabled() {
+		factor = params.EmissionFactor(k.BondedRatio(ctx))
+	}
+	mintAmountInt, carry := applyStakingFeedback(scheduledAmount, minter.EmissionCarry, factor, remainingBlocks(minter.NormTimePassed, incr))
+
 	mintedCoin := sdk.NewCoin(denom, mintAmountInt)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 	err := k.MintCoins(ctx, mintedCoins)
@@ -102,7 +139,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 	minter.NormTimePassed = minter.NormTimePassed.Add(incr)
-	minter.MintRemainder = mintAmountDec.Sub(mintAmountInt.ToDec())
+	minter.MintRemainder = scheduledAmountDec.Sub(scheduledAmount.ToDec())
+	minter.EmissionCarry = carry
 	k.SetMinter(ctx, minter)
 
 	// send the minted coins to the fee collector account
@@ -122,6 +160,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			types.EventTypeMint,
 			sdk.NewAttribute(types.AttributeMintedDenom, denom),
 			sdk.NewAttribute(types.AttributeMintedTokens, mintAmountInt.String()),
+			sdk.NewAttribute(types.AttributeEmissionFactor, factor.String()),
+			sdk.NewAttribute(types.AttributeEmissionCarry, carry.String()),
 		),
 	)
 }
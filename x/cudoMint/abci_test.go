@@ -8,6 +8,7 @@ import (
 	"github.com/CudoVentures/cudos-node/x/cudoMint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -16,7 +17,9 @@ func TestCalculateMintedCoins(t *testing.T) {
 	app := simapp.Setup(false)
 
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
-	app.CudoMintKeeper.SetParams(ctx, types.NewParams(sdk.NewInt(10)))
+	params := types.DefaultParams()
+	params.IncrementModifier = sdk.NewInt(10)
+	app.CudoMintKeeper.SetParams(ctx, params)
 	totalBlocks := int64(100000)
 	for height := int64(1); height <= totalBlocks; height++ {
 		ctx = ctx.WithBlockHeight(height)
@@ -29,3 +32,102 @@ func TestCalculateMintedCoins(t *testing.T) {
 	expectedSupply, _ := sdk.NewIntFromString("1530000000000000000000000000")
 	require.Equal(t, expectedSupply.String(), app.BankKeeper.GetSupply(ctx, "acudos").Amount.String())
 }
+
+func TestEmissionFactor(t *testing.T) {
+	params := types.DefaultParams()
+	params.GoalBonded = sdk.MustNewDecFromStr("0.6")
+	params.EmissionFactorMin = sdk.MustNewDecFromStr("0.5")
+	params.EmissionFactorMax = sdk.MustNewDecFromStr("1.5")
+	require.NoError(t, params.Validate())
+	require.True(t, params.StakingFeedbackEnabled())
+
+	for bondedRatio, factor := range map[string]string{
+		"0":   "1.5",
+		"0.3": "1.25",
+		"0.6": "1",
+		"0.8": "0.75",
+		"1":   "0.5",
+	} {
+		require.Equal(t, sdk.MustNewDecFromStr(factor), params.EmissionFactor(sdk.MustNewDecFromStr(bondedRatio)), bondedRatio)
+	}
+
+	require.False(t, types.DefaultParams().StakingFeedbackEnabled())
+
+	params.EmissionFactorMin = sdk.MustNewDecFromStr("1.1")
+	require.Error(t, params.Validate())
+	params.EmissionFactorMin = sdk.OneDec()
+	params.EmissionFactorMax = sdk.MustNewDecFromStr("0.9")
+	require.Error(t, params.Validate())
+	params.EmissionFactorMax = sdk.OneDec()
+	params.GoalBonded = sdk.OneDec()
+	require.Error(t, params.Validate())
+}
+
+func TestStakingFeedbackPreservesEmission(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// bond half of the staking tokens
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	stake := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, stake.Add(stake...)))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, stakingtypes.BondedPoolName, stake))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), app.StakingKeeper.BondedRatio(ctx))
+
+	params := types.DefaultParams()
+	params.IncrementModifier = sdk.NewInt(10)
+	params.GoalBonded = sdk.MustNewDecFromStr("0.25")
+	params.EmissionFactorMin = sdk.MustNewDecFromStr("0.4")
+	params.EmissionFactorMax = sdk.MustNewDecFromStr("1.6")
+	app.CudoMintKeeper.SetParams(ctx, params)
+
+	schedule, err := cudoMint.EmissionSchedule(params.IncrementModifier, sdk.ZeroDec(), 10000, 20000, 10000)
+	require.NoError(t, err)
+
+	// more is bonded than the goal, part of the emission is held back
+	height := int64(1)
+	for ; height <= 10000; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		cudoMint.BeginBlocker(ctx, app.CudoMintKeeper)
+	}
+
+	carry := app.CudoMintKeeper.GetMinter(ctx).EmissionCarry
+	supply := app.BankKeeper.GetSupply(ctx, types.MintDenom).Amount
+	require.True(t, carry.IsPositive())
+	require.Equal(t, schedule[0].Supply, supply.Add(carry))
+	heldBack := sdk.MustNewDecFromStr("0.2").MulInt(schedule[0].Supply).TruncateInt()
+	require.True(t, carry.LT(heldBack))
+	require.True(t, carry.GT(heldBack.MulRaw(8).QuoRaw(10)))
+
+	// less is bonded than the goal, the held back emission is paid out
+	params.GoalBonded = sdk.MustNewDecFromStr("0.75")
+	app.CudoMintKeeper.SetParams(ctx, params)
+	for ; height <= 20000; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		cudoMint.BeginBlocker(ctx, app.CudoMintKeeper)
+	}
+
+	require.True(t, app.CudoMintKeeper.GetMinter(ctx).EmissionCarry.LT(carry))
+	carry = app.CudoMintKeeper.GetMinter(ctx).EmissionCarry
+	supply = app.BankKeeper.GetSupply(ctx, types.MintDenom).Amount
+	require.Equal(t, schedule[1].Supply, supply.Add(carry))
+
+	// the carry is paid out over the remaining blocks, the last block mints
+	// what is still held back
+	params.GoalBonded = sdk.MustNewDecFromStr("0.25")
+	app.CudoMintKeeper.SetParams(ctx, params)
+	lastHeight := cudoMint.EmissionEndHeight(params.IncrementModifier, sdk.ZeroDec()) - 1
+	for ; height < lastHeight; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		cudoMint.BeginBlocker(ctx, app.CudoMintKeeper)
+	}
+	require.True(t, app.CudoMintKeeper.GetMinter(ctx).EmissionCarry.LT(carry.QuoRaw(100)))
+	for ; height <= 100000; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		cudoMint.BeginBlocker(ctx, app.CudoMintKeeper)
+	}
+
+	require.True(t, app.CudoMintKeeper.GetMinter(ctx).EmissionCarry.IsZero())
+	expectedSupply, _ := sdk.NewIntFromString("1530000000000000000000000000")
+	require.Equal(t, expectedSupply.String(), app.BankKeeper.GetSupply(ctx, types.MintDenom).Amount.String())
+}
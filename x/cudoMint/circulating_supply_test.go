@@ -0,0 +1,72 @@
+package cudoMint_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/CudoVentures/cudos-node/simapp"
+	"github.com/CudoVentures/cudos-node/x/cudoMint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestCirculatingSupply(t *testing.T) {
+	app := simapp.Setup(false)
+	start := time.Unix(1_600_000_000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: start.Add(250 * time.Second)})
+	querier := sdk.WrapSDKContext(ctx)
+
+	before, err := app.CudoMintKeeper.CirculatingSupply(querier, &types.QueryCirculatingSupplyRequest{})
+	require.NoError(t, err)
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(types.MintDenom, amount))
+	}
+	fund := func(addr sdk.AccAddress, amount int64) {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, coins(amount)))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins(amount)))
+	}
+	newAddr := func() sdk.AccAddress {
+		return sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	}
+
+	// a quarter of the vesting account is vested
+	vestingAddr := newAddr()
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(vestingAddr), coins(1000), start.Unix(), start.Add(1000*time.Second).Unix())
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, vestingAcc))
+	fund(vestingAddr, 1000)
+
+	circulatingAddr := newAddr()
+	fund(circulatingAddr, 100)
+
+	treasury, excludedVesting := newAddr(), newAddr()
+	fund(treasury, 300)
+	excludedAcc := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(excludedVesting), coins(200), start.Unix(), start.Add(1000*time.Second).Unix())
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, excludedAcc))
+	fund(excludedVesting, 200)
+	params := app.CudoMintKeeper.GetParams(ctx)
+	params.ExcludedAddresses = []string{treasury.String(), excludedVesting.String()}
+	app.CudoMintKeeper.SetParams(ctx, params)
+
+	// the community pool is held by the distribution module account
+	fund(circulatingAddr, 400)
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, coins(400), circulatingAddr))
+
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, coins(50)))
+
+	res, err := app.CudoMintKeeper.CirculatingSupply(querier, &types.QueryCirculatingSupplyRequest{})
+	require.NoError(t, err)
+
+	require.Equal(t, before.TotalSupply.AddRaw(2050), res.TotalSupply)
+	require.Equal(t, before.VestingLocked.AddRaw(750), res.VestingLocked)
+	require.Equal(t, before.CommunityPool.AddRaw(400), res.CommunityPool)
+	require.Equal(t, before.ModuleAccounts.AddRaw(50), res.ModuleAccounts)
+	require.Equal(t, before.ExcludedAddresses.AddRaw(500), res.ExcludedAddresses)
+	require.Equal(t, before.CirculatingSupply.AddRaw(350), res.CirculatingSupply)
+	require.Equal(t, res.TotalSupply, res.CirculatingSupply.Add(res.VestingLocked).Add(res.CommunityPool).Add(res.ModuleAccounts).Add(res.ExcludedAddresses))
+}
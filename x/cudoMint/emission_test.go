@@ -0,0 +1,134 @@
+package cudoMint_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/CudoVentures/cudos-node/simapp"
+	"github.com/CudoVentures/cudos-node/x/cudoMint"
+	"github.com/CudoVentures/cudos-node/x/cudoMint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+var update = flag.Bool("update", false, "regenerate the emission golden files")
+
+// emissionCase runs the BeginBlocker for a number of blocks and records every
+// sampled block, as well as the last one, in testdata/emission/<name>.csv.
+type emissionCase struct {
+	name              string
+	incrementModifier int64
+	normTimePassed    string
+	blocks            int64
+	every             int64
+}
+
+var emissionCases = []emissionCase{
+	// the whole curve in 36520 blocks, followed by blocks minting nothing
+	{name: "full_curve", incrementModifier: 10, normTimePassed: "0", blocks: 37000, every: 500},
+	{name: "mainnet_start", incrementModifier: 17280, normTimePassed: "0", blocks: 2000, every: 10},
+	{name: "mainnet_end", incrementModifier: 17280, normTimePassed: "9.9999", blocks: 700, every: 1},
+	{name: "slow_blocks", incrementModifier: 1, normTimePassed: "0", blocks: 3700, every: 50},
+}
+
+// TestEmissionGolden compares the emission with the golden files, which are
+// regenerated with: go test ./x/cudoMint -run TestEmissionGolden -update
+func TestEmissionGolden(t *testing.T) {
+	for _, c := range emissionCases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			got := runEmission(t, c)
+			golden := filepath.Join("testdata", "emission", c.name+".csv")
+
+			if *update {
+				require.NoError(t, ioutil.WriteFile(golden, got, 0o644))
+				return
+			}
+
+			want, err := ioutil.ReadFile(golden)
+			require.NoError(t, err, "run the test with -update to create the golden file")
+			if !bytes.Equal(want, got) {
+				t.Fatalf("emission differs from %s: %s", golden, firstDiff(want, got))
+			}
+		})
+	}
+}
+
+// TestEmissionSchedule checks that the offline schedule matches the golden
+// files of the BeginBlocker.
+func TestEmissionSchedule(t *testing.T) {
+	for _, c := range emissionCases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			schedule, err := cudoMint.EmissionSchedule(sdk.NewInt(c.incrementModifier), sdk.MustNewDecFromStr(c.normTimePassed), c.every, c.blocks, c.every)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			fmt.Fprintln(&buf, "height,minted,supply,norm_time_passed")
+			for _, point := range schedule {
+				fmt.Fprintf(&buf, "%d,%s,%s,%s\n", point.Height, point.Minted, point.Supply, point.NormTimePassed)
+			}
+
+			golden := filepath.Join("testdata", "emission", c.name+".csv")
+			want, err := ioutil.ReadFile(golden)
+			require.NoError(t, err)
+			if !bytes.Equal(want, buf.Bytes()) {
+				t.Fatalf("schedule differs from %s: %s", golden, firstDiff(want, buf.Bytes()))
+			}
+		})
+	}
+}
+
+func runEmission(t *testing.T, c emissionCase) []byte {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	normTimePassed, err := sdk.NewDecFromStr(c.normTimePassed)
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.IncrementModifier = sdk.NewInt(c.incrementModifier)
+	app.CudoMintKeeper.SetParams(ctx, params)
+	app.CudoMintKeeper.SetMinter(ctx, types.NewMinter(sdk.ZeroDec(), normTimePassed))
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "height,minted,supply,norm_time_passed")
+
+	supply := app.BankKeeper.GetSupply(ctx, types.MintDenom).Amount
+	for height := int64(1); height <= c.blocks; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		cudoMint.BeginBlocker(ctx, app.CudoMintKeeper)
+
+		newSupply := app.BankKeeper.GetSupply(ctx, types.MintDenom).Amount
+		if height%c.every == 0 || height == c.blocks {
+			fmt.Fprintf(&buf, "%d,%s,%s,%s\n", height, newSupply.Sub(supply), newSupply, app.CudoMintKeeper.GetMinter(ctx).NormTimePassed)
+		}
+		supply = newSupply
+	}
+
+	return buf.Bytes()
+}
+
+func firstDiff(want, got []byte) string {
+	wantLines := strings.Split(string(want), "\n")
+	gotLines := strings.Split(string(got), "\n")
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d\nwant: %s\ngot:  %s", i+1, w, g)
+		}
+	}
+	return "no difference"
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+// circulatingSupply returns the total supply of the mint denom minus the
+// coins locked in vesting accounts, the balances of the module accounts and
+// the balances of the excluded addresses. Every balance is counted once: the
+// community pool is part of the distribution module account, and excluded
+// addresses are not counted as vesting or module accounts.
+func (k Keeper) circulatingSupply(ctx sdk.Context) types.QueryCirculatingSupplyResponse {
+	res := types.QueryCirculatingSupplyResponse{
+		TotalSupply:       k.bankKeeper.GetSupply(ctx, types.MintDenom).Amount,
+		VestingLocked:     sdk.ZeroInt(),
+		CommunityPool:     k.distrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(types.MintDenom).TruncateInt(),
+		ModuleAccounts:    sdk.ZeroInt(),
+		ExcludedAddresses: sdk.ZeroInt(),
+	}
+
+	excluded := make(map[string]bool)
+	for _, addr := range k.GetParams(ctx).ExcludedAddresses {
+		if k.moduleAccAddrs[addr] {
+			continue
+		}
+		excluded[addr] = true
+		res.ExcludedAddresses = res.ExcludedAddresses.Add(k.bankKeeper.GetBalance(ctx, mustAccAddress(addr), types.MintDenom).Amount)
+	}
+
+	moduleBalances := sdk.ZeroInt()
+	for addr := range k.moduleAccAddrs {
+		moduleBalances = moduleBalances.Add(k.bankKeeper.GetBalance(ctx, mustAccAddress(addr), types.MintDenom).Amount)
+	}
+	// the truncated community pool is at most the distribution module balance
+	res.ModuleAccounts = moduleBalances.Sub(res.CommunityPool)
+
+	blockTime := ctx.BlockTime()
+	k.accountKeeper.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		vacc, ok := acc.(vestexported.VestingAccount)
+		if !ok || excluded[acc.GetAddress().String()] || k.moduleAccAddrs[acc.GetAddress().String()] {
+			return false
+		}
+
+		// delegated vesting coins are not locked in the balance but in the
+		// staking pools, which are module accounts
+		locked := vacc.LockedCoins(blockTime).AmountOf(types.MintDenom)
+		balance := k.bankKeeper.GetBalance(ctx, acc.GetAddress(), types.MintDenom).Amount
+		res.VestingLocked = res.VestingLocked.Add(sdk.MinInt(locked, balance))
+		return false
+	})
+
+	res.CirculatingSupply = res.TotalSupply.
+		Sub(res.VestingLocked).
+		Sub(res.CommunityPool).
+		Sub(res.ModuleAccounts).
+		Sub(res.ExcludedAddresses)
+
+	return res
+}
+
+// mustAccAddress parses the validated excluded addresses and the module
+// account addresses of the app.
+func mustAccAddress(addr string) sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		panic(err)
+	}
+	return accAddr
+}
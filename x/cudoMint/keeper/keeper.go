@@ -18,7 +18,11 @@ type (
 		storeKey         sdk.StoreKey
 		memKey           sdk.StoreKey
 		bankKeeper       types.BankKeeper
+		accountKeeper    types.AccountKeeper
+		distrKeeper      types.DistributionKeeper
+		stakingKeeper    types.StakingKeeper
 		feeCollectorName string
+		moduleAccAddrs   map[string]bool
 		paramSpace       paramtypes.Subspace
 		// this line is used by starport scaffolding # ibc/keeper/attribute
 	}
@@ -30,8 +34,11 @@ func NewKeeper(
 	memKey sdk.StoreKey,
 	bk types.BankKeeper,
 	ak types.AccountKeeper,
+	dk types.DistributionKeeper,
+	sk types.StakingKeeper,
 	paramSpace paramtypes.Subspace,
 	feeCollectorName string,
+	moduleAccAddrs map[string]bool,
 	// this line is used by starport scaffolding # ibc/keeper/parameter
 ) *Keeper {
 	// ensure mint module account is set
@@ -48,8 +55,12 @@ func NewKeeper(
 		storeKey:         storeKey,
 		memKey:           memKey,
 		bankKeeper:       bk,
+		accountKeeper:    ak,
+		distrKeeper:      dk,
+		stakingKeeper:    sk,
 		paramSpace:       paramSpace,
 		feeCollectorName: feeCollectorName,
+		moduleAccAddrs:   moduleAccAddrs,
 		// this line is used by starport scaffolding # ibc/keeper/return
 	}
 }
@@ -99,6 +110,12 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, newCoins)
 }
 
+// BondedRatio implements an alias call to the underlying staking keeper's
+// BondedRatio to be used in BeginBlocker.
+func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
+	return k.stakingKeeper.BondedRatio(ctx)
+}
+
 // AddCollectedFees implements an alias call to the underlying supply keeper's
 // AddCollectedFees to be used in BeginBlocker.
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
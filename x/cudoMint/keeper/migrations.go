@@ -1,5 +1,10 @@
 package keeper
 
+import (
+	"github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +14,24 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate1to2 migrates from version 1 to 2 by adding the excluded addresses
+// param, which starts empty.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ExcludedAddresses, []string{})
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3 by adding the staking feedback
+// params, which start disabled, and the emission carry, which starts empty.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.keeper.paramSpace.Set(ctx, types.GoalBonded, defaults.GoalBonded)
+	m.keeper.paramSpace.Set(ctx, types.EmissionFactorMin, defaults.EmissionFactorMin)
+	m.keeper.paramSpace.Set(ctx, types.EmissionFactorMax, defaults.EmissionFactorMax)
+
+	minter := m.keeper.GetMinter(ctx)
+	minter.EmissionCarry = sdk.ZeroInt()
+	m.keeper.SetMinter(ctx, minter)
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	cudoMinttypes "github.com/CudoVentures/cudos-node/x/cudoMint/types"
+)
+
+// GenesisState is the cudoMint genesis state exported by v1 chains, whose
+// params still count the blocks per day.
+type GenesisState struct {
+	Minter cudoMinttypes.Minter `json:"minter"`
+	Params Params               `json:"params"`
+}
+
+// Migrate converts a v1 cudoMint genesis state, the blocks per day become
+// the increment modifier. The params and minter added since start out at
+// their defaults.
+func Migrate(oldGenState GenesisState) *cudoMinttypes.GenesisState {
+	defaults := cudoMinttypes.DefaultParams()
+	return cudoMinttypes.NewGenesisState(
+		cudoMinttypes.NewMinter(oldGenState.Minter.MintRemainder, oldGenState.Minter.NormTimePassed),
+		cudoMinttypes.NewParams(
+			oldGenState.Params.BlocksPerDay,
+			[]string{},
+			defaults.GoalBonded,
+			defaults.EmissionFactorMin,
+			defaults.EmissionFactorMax,
+		),
+	)
+}
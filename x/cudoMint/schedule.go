@@ -0,0 +1,93 @@
+package cudoMint
+
+import (
+	"fmt"
+
+	"github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EmissionPoint is the state of the emission after the BeginBlocker of a block.
+type EmissionPoint struct {
+	Height         int64   `json:"height"`
+	NormTimePassed sdk.Dec `json:"norm_time_passed"`
+	Minted         sdk.Int `json:"minted"`
+	Supply         sdk.Int `json:"supply"`
+}
+
+// EmissionEndHeight returns the first block that mints nothing, for a chain
+// whose minter started at initialNormTimePassed.
+func EmissionEndHeight(incrementModifier sdk.Int, initialNormTimePassed sdk.Dec) int64 {
+	if initialNormTimePassed.GT(FinalNormTimePassed) {
+		return 1
+	}
+
+	// the minter stops after the block that takes it past FinalNormTimePassed
+	incr := normalizeBlockHeightInc(incrementModifier)
+	blocks := FinalNormTimePassed.Sub(initialNormTimePassed).BigInt()
+	blocks.Quo(blocks, incr.BigInt())
+	return blocks.Int64() + 2
+}
+
+// EmissionSchedule computes the emission of every step-th block between
+// fromHeight and toHeight, and of toHeight, as the BeginBlocker would for a
+// chain whose minter started at initialNormTimePassed and without the staking
+// feedback, which makes the chain mint more or less per block while enabled.
+// Supply is the amount minted since the first block.
+func EmissionSchedule(incrementModifier sdk.Int, initialNormTimePassed sdk.Dec, fromHeight, toHeight, step int64) ([]EmissionPoint, error) {
+	params := types.DefaultParams()
+	params.IncrementModifier = incrementModifier
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if initialNormTimePassed.IsNegative() {
+		return nil, fmt.Errorf("norm time passed cannot be negative")
+	}
+	if fromHeight < 1 || toHeight < fromHeight {
+		return nil, fmt.Errorf("invalid height range %d to %d", fromHeight, toHeight)
+	}
+	if step < 1 {
+		return nil, fmt.Errorf("step must be positive")
+	}
+
+	incr := normalizeBlockHeightInc(incrementModifier)
+	endHeight := EmissionEndHeight(incrementModifier, initialNormTimePassed)
+	mintedBefore := calculateIntegral(sdk.MinDec(initialNormTimePassed, FinalNormTimePassed))
+
+	// the norm time passed before the BeginBlocker of a block
+	normTimeBefore := func(height int64) sdk.Dec {
+		if height > endHeight {
+			height = endHeight
+		}
+		return initialNormTimePassed.Add(incr.MulInt64(height - 1))
+	}
+
+	var schedule []EmissionPoint
+	for height := fromHeight; height < toHeight; height += step {
+		schedule = append(schedule, emissionPoint(height, incr, normTimeBefore, mintedBefore))
+	}
+	schedule = append(schedule, emissionPoint(toHeight, incr, normTimeBefore, mintedBefore))
+
+	return schedule, nil
+}
+
+// emissionPoint relies on the minted amounts having no decimals, which makes
+// the supply the integral up to the norm time passed after the block.
+func emissionPoint(height int64, incr sdk.Dec, normTimeBefore func(int64) sdk.Dec, mintedBefore sdk.Dec) EmissionPoint {
+	before := normTimeBefore(height)
+	after := normTimeBefore(height + 1)
+
+	minted := sdk.ZeroInt()
+	if !before.GT(FinalNormTimePassed) {
+		minted = calculateMintedCoins(types.NewMinter(sdk.ZeroDec(), before), incr).TruncateInt()
+	}
+
+	supply := calculateIntegral(sdk.MinDec(after, FinalNormTimePassed)).Sub(mintedBefore)
+
+	return EmissionPoint{
+		Height:         height,
+		NormTimePassed: after,
+		Minted:         minted,
+		Supply:         supply.Mul(sdk.NewDec(10).Power(24)).TruncateInt(),
+	}
+}
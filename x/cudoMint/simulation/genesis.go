@@ -0,0 +1,105 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Simulation parameter constants
+const (
+	IncrementModifier = "increment_modifier"
+	NormTimePassed    = "norm_time_passed"
+	MintRemainder     = "mint_remainder"
+	GoalBonded        = "goal_bonded"
+	EmissionFactorMin = "emission_factor_min"
+	EmissionFactorMax = "emission_factor_max"
+)
+
+// GenIncrementModifier randomized IncrementModifier, between a block a
+// minute and a block a second
+func GenIncrementModifier(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(int64(1440 + r.Intn(86400-1440+1)))
+}
+
+// GenNormTimePassed randomized NormTimePassed, the minter progress on the
+// emission curve of 10 normalized years
+func GenNormTimePassed(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(r.Int63n(10000), 3)
+}
+
+// GenMintRemainder randomized MintRemainder, the fraction of an acudos left
+// over by the last mint
+func GenMintRemainder(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(r.Int63n(sdk.DefaultPowerReduction.Int64()), sdk.Precision)
+}
+
+// GenGoalBonded randomized GoalBonded, between 50% and 90%
+func GenGoalBonded(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(50+r.Intn(41)), 2)
+}
+
+// GenEmissionFactorMin randomized EmissionFactorMin, between 0.5 and 1
+func GenEmissionFactorMin(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(50+r.Intn(51)), 2)
+}
+
+// GenEmissionFactorMax randomized EmissionFactorMax, between 1 and 2
+func GenEmissionFactorMax(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(100+r.Intn(101)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for cudoMint
+func RandomizedGenState(simState *module.SimulationState) {
+	var incrementModifier sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, IncrementModifier, &incrementModifier, simState.Rand,
+		func(r *rand.Rand) { incrementModifier = GenIncrementModifier(r) },
+	)
+
+	var normTimePassed sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, NormTimePassed, &normTimePassed, simState.Rand,
+		func(r *rand.Rand) { normTimePassed = GenNormTimePassed(r) },
+	)
+
+	var mintRemainder sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintRemainder, &mintRemainder, simState.Rand,
+		func(r *rand.Rand) { mintRemainder = GenMintRemainder(r) },
+	)
+
+	var goalBonded sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GoalBonded, &goalBonded, simState.Rand,
+		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
+	)
+
+	var emissionFactorMin sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EmissionFactorMin, &emissionFactorMin, simState.Rand,
+		func(r *rand.Rand) { emissionFactorMin = GenEmissionFactorMin(r) },
+	)
+
+	var emissionFactorMax sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EmissionFactorMax, &emissionFactorMax, simState.Rand,
+		func(r *rand.Rand) { emissionFactorMax = GenEmissionFactorMax(r) },
+	)
+
+	params := types.NewParams(incrementModifier, []string{}, goalBonded, emissionFactorMin, emissionFactorMax)
+	cudoMintGenesis := types.NewGenesisState(types.NewMinter(mintRemainder, normTimePassed), params)
+
+	bz, err := json.MarshalIndent(cudoMintGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated cudoMint parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(cudoMintGenesis)
+}
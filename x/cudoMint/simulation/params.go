@@ -0,0 +1,39 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/CudoVentures/cudos-node/x/cudoMint/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.IncrementModifier),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenIncrementModifier(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.GoalBonded),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenGoalBonded(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.EmissionFactorMin),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenEmissionFactorMin(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.EmissionFactorMax),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenEmissionFactorMax(r))
+			},
+		),
+	}
+}
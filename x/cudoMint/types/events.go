@@ -4,6 +4,8 @@ package types
 const (
 	EventTypeMint = ModuleName
 
-	AttributeMintedDenom  = "minted_denom"
-	AttributeMintedTokens = "minted_tokens"
+	AttributeMintedDenom    = "minted_denom"
+	AttributeMintedTokens   = "minted_tokens"
+	AttributeEmissionFactor = "emission_factor"
+	AttributeEmissionCarry  = "emission_carry"
 )
@@ -18,6 +18,10 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_capability"
 
+	// MintDenom is the denomination minted by the module. Its not changeable,
+	// because some of the minting math depends on the size of this denomination.
+	MintDenom = "acudos"
+
 	// this line is used by starport scaffolding # ibc/keys/name
 )
 
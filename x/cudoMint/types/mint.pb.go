@@ -28,6 +28,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Minter struct {
 	MintRemainder  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=mint_remainder,json=mintRemainder,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mint_remainder"`
 	NormTimePassed github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=norm_time_passed,json=normTimePassed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"norm_time_passed"`
+	// emission_carry is the scheduled emission held back by the staking
+	// feedback that has not been minted yet.
+	EmissionCarry github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=emission_carry,json=emissionCarry,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"emission_carry"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -65,6 +68,16 @@ var xxx_messageInfo_Minter proto.InternalMessageInfo
 
 type Params struct {
 	IncrementModifier github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=increment_modifier,json=incrementModifier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"increment_modifier"`
+	// excluded_addresses are the treasury addresses whose balances do not
+	// count towards the circulating supply.
+	ExcludedAddresses []string `protobuf:"bytes,2,rep,name=excluded_addresses,json=excludedAddresses,proto3" json:"excluded_addresses,omitempty"`
+	// goal_bonded is the bonded ratio the staking feedback steers towards.
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
+	// emission_factor_min scales the emission when all tokens are bonded.
+	EmissionFactorMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=emission_factor_min,json=emissionFactorMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission_factor_min"`
+	// emission_factor_max scales the emission when no tokens are bonded. The
+	// staking feedback is disabled when both factors are one.
+	EmissionFactorMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=emission_factor_max,json=emissionFactorMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission_factor_max"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -100,6 +113,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetExcludedAddresses() []string {
+	if m != nil {
+		return m.ExcludedAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cudos.cudoMint.Minter")
 	proto.RegisterType((*Params)(nil), "cudos.cudoMint.Params")
@@ -108,26 +128,32 @@ func init() {
 func init() { proto.RegisterFile("cudos/cudoMint/mint.proto", fileDescriptor_994b2dd3048affd2) }
 
 var fileDescriptor_994b2dd3048affd2 = []byte{
-	// 289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd1, 0x41, 0x4a, 0x33, 0x31,
-	0x14, 0x07, 0xf0, 0xc9, 0xb7, 0x28, 0x7c, 0x01, 0x07, 0x1d, 0x5c, 0x54, 0x17, 0xa9, 0x74, 0x21,
-	0x6e, 0x3a, 0x59, 0xf4, 0x06, 0xd5, 0x8d, 0x8b, 0x81, 0x32, 0xa8, 0x88, 0x20, 0xc3, 0x74, 0xf2,
-	0x1c, 0x83, 0x24, 0x6f, 0x48, 0x32, 0xa0, 0xb7, 0xf0, 0x3a, 0xde, 0xa0, 0xcb, 0x2e, 0xc5, 0x45,
-	0x91, 0x99, 0x8b, 0x48, 0x52, 0xab, 0xae, 0xbb, 0x49, 0x02, 0xff, 0xe4, 0xf7, 0xc2, 0x7b, 0xf4,
-	0xa8, 0x6a, 0x05, 0x5a, 0xee, 0xd7, 0x4c, 0x6a, 0xc7, 0x95, 0xd4, 0x2e, 0x6d, 0x0c, 0x3a, 0x4c,
-	0xe2, 0x10, 0xa5, 0xdb, 0xe8, 0xf8, 0xb0, 0xc6, 0x1a, 0x43, 0xc4, 0xfd, 0x69, 0x73, 0x6b, 0xfc,
-	0x46, 0xe8, 0xc0, 0xc7, 0x60, 0x92, 0x6b, 0x1a, 0xfb, 0xe7, 0x85, 0x01, 0x55, 0x4a, 0x2d, 0xc0,
-	0x0c, 0xc9, 0x09, 0x39, 0xfb, 0x3f, 0x4b, 0x97, 0xeb, 0x51, 0xf4, 0xb1, 0x1e, 0x9d, 0xd6, 0xd2,
-	0x3d, 0xb6, 0x8b, 0xb4, 0x42, 0xc5, 0x2b, 0xb4, 0xca, 0xd7, 0x0d, 0xdb, 0xc4, 0x8a, 0x27, 0xee,
-	0x5e, 0x1a, 0xb0, 0xe9, 0x05, 0x54, 0xf9, 0x9e, 0x57, 0xf2, 0x2d, 0x92, 0xdc, 0xd2, 0x7d, 0x8d,
-	0x46, 0x15, 0x4e, 0x2a, 0x28, 0x9a, 0xd2, 0x5a, 0x10, 0xc3, 0x7f, 0x3b, 0xc1, 0xb1, 0x77, 0xae,
-	0xa4, 0x82, 0x79, 0x50, 0xc6, 0x35, 0x1d, 0xcc, 0x4b, 0x53, 0x2a, 0x9b, 0xdc, 0xd3, 0x44, 0xea,
-	0xca, 0x80, 0x02, 0xed, 0x0a, 0x85, 0x42, 0x3e, 0xc8, 0x9d, 0xbe, 0x7f, 0xa9, 0x5d, 0x7e, 0xf0,
-	0x23, 0x65, 0xdf, 0xd0, 0x2c, 0x5b, 0x76, 0x8c, 0xac, 0x3a, 0x46, 0x3e, 0x3b, 0x46, 0x5e, 0x7b,
-	0x16, 0xad, 0x7a, 0x16, 0xbd, 0xf7, 0x2c, 0xba, 0x9b, 0xfe, 0x41, 0xcf, 0x5b, 0x81, 0x37, 0xa0,
-	0x5d, 0x6b, 0x60, 0x33, 0x11, 0x3b, 0xd1, 0x28, 0x80, 0x3f, 0xff, 0x8e, 0x27, 0x54, 0x59, 0x0c,
-	0x42, 0xeb, 0xa7, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xa9, 0xe5, 0xdd, 0xbd, 0x01, 0x00,
-	0x00,
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0xd3, 0xc1, 0x6a, 0x14, 0x31,
+	0x18, 0x07, 0xf0, 0x99, 0x1d, 0x5d, 0x68, 0xa4, 0x8b, 0x1b, 0x3d, 0x8c, 0x1e, 0xa6, 0xa5, 0x07,
+	0xe9, 0x65, 0x67, 0x0e, 0x7d, 0x02, 0xb7, 0x22, 0x78, 0x18, 0x2c, 0x83, 0x8a, 0x08, 0x1a, 0xb2,
+	0xc9, 0xd7, 0x31, 0xd8, 0xe4, 0x5b, 0x92, 0x0c, 0x4c, 0xdf, 0xa2, 0x6f, 0xe1, 0xab, 0xf4, 0xd8,
+	0xa3, 0x78, 0x58, 0x64, 0xf7, 0x45, 0x24, 0xb3, 0x3b, 0xab, 0x07, 0x2f, 0x0e, 0xbd, 0x24, 0x81,
+	0x7f, 0xf2, 0xfb, 0xc2, 0x07, 0x1f, 0x79, 0x26, 0x1a, 0x89, 0xae, 0x08, 0x6b, 0xa9, 0x8c, 0x2f,
+	0xb4, 0x32, 0x3e, 0x5f, 0x5a, 0xf4, 0x48, 0x27, 0x5d, 0x94, 0xf7, 0xd1, 0xf3, 0xa7, 0x35, 0xd6,
+	0xd8, 0x45, 0x45, 0x38, 0x6d, 0x6f, 0x9d, 0xdc, 0x8c, 0xc8, 0x38, 0xc4, 0x60, 0xe9, 0x7b, 0x32,
+	0x09, 0xcf, 0x99, 0x05, 0xcd, 0x95, 0x91, 0x60, 0xd3, 0xf8, 0x38, 0x3e, 0x3d, 0x98, 0xe7, 0xb7,
+	0xab, 0xa3, 0xe8, 0xe7, 0xea, 0xe8, 0x45, 0xad, 0xfc, 0xd7, 0x66, 0x91, 0x0b, 0xd4, 0x85, 0x40,
+	0xa7, 0xd1, 0xed, 0xb6, 0x99, 0x93, 0xdf, 0x0a, 0x7f, 0xbd, 0x04, 0x97, 0xbf, 0x02, 0x51, 0x1d,
+	0x06, 0xa5, 0xea, 0x11, 0xfa, 0x91, 0x3c, 0x36, 0x68, 0x35, 0xf3, 0x4a, 0x03, 0x5b, 0x72, 0xe7,
+	0x40, 0xa6, 0xa3, 0x41, 0xf0, 0x24, 0x38, 0xef, 0x94, 0x86, 0x8b, 0x4e, 0x09, 0x1f, 0x06, 0xad,
+	0x9c, 0x53, 0x68, 0x98, 0xe0, 0xd6, 0x5e, 0xa7, 0xc9, 0x7f, 0xbb, 0x6f, 0x8c, 0xaf, 0x0e, 0x7b,
+	0xe5, 0x3c, 0x20, 0x27, 0xdf, 0x13, 0x32, 0xbe, 0xe0, 0x96, 0x6b, 0x47, 0x3f, 0x13, 0xaa, 0x8c,
+	0xb0, 0xa0, 0xc1, 0x78, 0xa6, 0x51, 0xaa, 0x4b, 0x35, 0xa8, 0x2d, 0xa1, 0xca, 0x74, 0x2f, 0x95,
+	0x3b, 0x88, 0xce, 0x08, 0x85, 0x56, 0x5c, 0x35, 0x12, 0x24, 0xe3, 0x52, 0x5a, 0x70, 0x0e, 0x5c,
+	0x3a, 0x3a, 0x4e, 0x4e, 0x0f, 0xaa, 0x69, 0x9f, 0xbc, 0xec, 0x03, 0xfa, 0x96, 0x3c, 0xaa, 0x91,
+	0x5f, 0xb1, 0x05, 0x1a, 0x09, 0x32, 0x4d, 0x06, 0x35, 0x91, 0x04, 0x62, 0xde, 0x09, 0xf4, 0x0b,
+	0x79, 0xb2, 0x6f, 0xe0, 0x25, 0x17, 0x1e, 0x2d, 0xd3, 0xca, 0xa4, 0x0f, 0x06, 0xc1, 0xd3, 0x9e,
+	0x7a, 0xdd, 0x49, 0xa5, 0x32, 0xff, 0xf4, 0x79, 0x9b, 0x3e, 0xbc, 0x17, 0x9f, 0xb7, 0xf3, 0xf2,
+	0x76, 0x9d, 0xc5, 0x77, 0xeb, 0x2c, 0xfe, 0xb5, 0xce, 0xe2, 0x9b, 0x4d, 0x16, 0xdd, 0x6d, 0xb2,
+	0xe8, 0xc7, 0x26, 0x8b, 0x3e, 0x9d, 0xfd, 0x85, 0x9e, 0x37, 0x12, 0x3f, 0x80, 0xf1, 0x8d, 0x85,
+	0xed, 0xa4, 0xb8, 0x99, 0x41, 0x09, 0x45, 0xfb, 0x67, 0x6c, 0xba, 0x2a, 0x8b, 0x71, 0x37, 0x12,
+	0x67, 0xbf, 0x07, 0x00, 0x19, 0x3a, 0xfe, 0x44, 0x55, 0x03, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -150,6 +176,16 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.EmissionCarry.Size()
+		i -= size
+		if _, err := m.EmissionCarry.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.NormTimePassed.Size()
 		i -= size
@@ -193,6 +229,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.EmissionFactorMax.Size()
+		i -= size
+		if _, err := m.EmissionFactorMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EmissionFactorMin.Size()
+		i -= size
+		if _, err := m.EmissionFactorMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ExcludedAddresses) > 0 {
+		for iNdEx := len(m.ExcludedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedAddresses[iNdEx])
+			copy(dAtA[i:], m.ExcludedAddresses[iNdEx])
+			i = encodeVarintMint(dAtA, i, uint64(len(m.ExcludedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size := m.IncrementModifier.Size()
 		i -= size
@@ -227,6 +302,8 @@ func (m *Minter) Size() (n int) {
 	n += 1 + l + sovMint(uint64(l))
 	l = m.NormTimePassed.Size()
 	n += 1 + l + sovMint(uint64(l))
+	l = m.EmissionCarry.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -238,6 +315,18 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.IncrementModifier.Size()
 	n += 1 + l + sovMint(uint64(l))
+	if len(m.ExcludedAddresses) > 0 {
+		for _, s := range m.ExcludedAddresses {
+			l = len(s)
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.EmissionFactorMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.EmissionFactorMax.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -344,6 +433,40 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionCarry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionCarry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -431,6 +554,140 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedAddresses = append(m.ExcludedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionFactorMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionFactorMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionFactorMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionFactorMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
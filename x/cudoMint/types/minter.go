@@ -12,6 +12,7 @@ func NewMinter(mintRemainder sdk.Dec, normTimePassed sdk.Dec) Minter {
 	return Minter{
 		MintRemainder:  mintRemainder,
 		NormTimePassed: normTimePassed,
+		EmissionCarry:  sdk.ZeroInt(),
 	}
 }
 
@@ -19,6 +20,7 @@ func DefaultInitialMinter() Minter {
 	return Minter{
 		MintRemainder:  sdk.ZeroDec(),
 		NormTimePassed: sdk.ZeroDec(),
+		EmissionCarry:  sdk.ZeroInt(),
 	}
 }
 
@@ -30,6 +32,11 @@ func ValidateMinter(minter Minter) error {
 	} else if minter.NormTimePassed.IsNegative() {
 		return fmt.Errorf("mint parameter NormTimePassed should be positive, is %s",
 			minter.NormTimePassed.String())
+	} else if minter.EmissionCarry.IsNil() {
+		return fmt.Errorf("mint parameter EmissionCarry must be set")
+	} else if minter.EmissionCarry.IsNegative() {
+		return fmt.Errorf("mint parameter EmissionCarry should be positive, is %s",
+			minter.EmissionCarry.String())
 	}
 
 	return nil
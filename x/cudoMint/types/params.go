@@ -10,6 +10,10 @@ import (
 // IncrementModifier Parameter store keys
 var (
 	IncrementModifier = []byte("IncrementModifier")
+	ExcludedAddresses = []byte("ExcludedAddresses")
+	GoalBonded        = []byte("GoalBonded")
+	EmissionFactorMin = []byte("EmissionFactorMin")
+	EmissionFactorMax = []byte("EmissionFactorMax")
 )
 
 // ParamKeyTable ParamTable for minting module.
@@ -19,10 +23,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(
 	incrementModifier sdk.Int,
+	excludedAddresses []string,
+	goalBonded sdk.Dec,
+	emissionFactorMin sdk.Dec,
+	emissionFactorMax sdk.Dec,
 ) Params {
 
 	return Params{
 		IncrementModifier: incrementModifier,
+		ExcludedAddresses: excludedAddresses,
+		GoalBonded:        goalBonded,
+		EmissionFactorMin: emissionFactorMin,
+		EmissionFactorMax: emissionFactorMax,
 	}
 }
 
@@ -30,6 +42,10 @@ func NewParams(
 func DefaultParams() Params {
 	return Params{
 		IncrementModifier: sdk.NewInt(17280), // assuming 5 second block times
+		ExcludedAddresses: []string{},
+		GoalBonded:        sdk.NewDecWithPrec(67, 2),
+		EmissionFactorMin: sdk.OneDec(), // the staking feedback is disabled
+		EmissionFactorMax: sdk.OneDec(),
 	}
 }
 
@@ -38,6 +54,18 @@ func (p Params) Validate() error {
 	if err := validateIncrementModifier(p.IncrementModifier); err != nil {
 		return err
 	}
+	if err := validateExcludedAddresses(p.ExcludedAddresses); err != nil {
+		return err
+	}
+	if err := validateGoalBonded(p.GoalBonded); err != nil {
+		return err
+	}
+	if err := validateEmissionFactorMin(p.EmissionFactorMin); err != nil {
+		return err
+	}
+	if err := validateEmissionFactorMax(p.EmissionFactorMax); err != nil {
+		return err
+	}
 
 	return nil
 
@@ -47,9 +75,36 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(IncrementModifier, &p.IncrementModifier, validateIncrementModifier),
+		paramtypes.NewParamSetPair(ExcludedAddresses, &p.ExcludedAddresses, validateExcludedAddresses),
+		paramtypes.NewParamSetPair(GoalBonded, &p.GoalBonded, validateGoalBonded),
+		paramtypes.NewParamSetPair(EmissionFactorMin, &p.EmissionFactorMin, validateEmissionFactorMin),
+		paramtypes.NewParamSetPair(EmissionFactorMax, &p.EmissionFactorMax, validateEmissionFactorMax),
 	}
 }
 
+// StakingFeedbackEnabled returns whether the emission depends on the bonded
+// ratio.
+func (p Params) StakingFeedbackEnabled() bool {
+	return !p.EmissionFactorMin.Equal(sdk.OneDec()) || !p.EmissionFactorMax.Equal(sdk.OneDec())
+}
+
+// EmissionFactor returns the factor the staking feedback scales the
+// scheduled emission with: EmissionFactorMax when nothing is bonded, one at
+// GoalBonded and EmissionFactorMin when everything is bonded, linear in
+// between.
+func (p Params) EmissionFactor(bondedRatio sdk.Dec) sdk.Dec {
+	one := sdk.OneDec()
+	bondedRatio = sdk.MaxDec(sdk.ZeroDec(), sdk.MinDec(bondedRatio, one))
+
+	if bondedRatio.LT(p.GoalBonded) {
+		shortfall := p.GoalBonded.Sub(bondedRatio).Quo(p.GoalBonded)
+		return one.Add(p.EmissionFactorMax.Sub(one).Mul(shortfall))
+	}
+
+	excess := bondedRatio.Sub(p.GoalBonded).Quo(one.Sub(p.GoalBonded))
+	return one.Sub(one.Sub(p.EmissionFactorMin).Mul(excess))
+}
+
 func validateIncrementModifier(i interface{}) error {
 	v, ok := i.(sdk.Int)
 	if !ok {
@@ -61,3 +116,67 @@ func validateIncrementModifier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateExcludedAddresses(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, addr := range v {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid excluded address %s: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate excluded address: %s", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+func validateGoalBonded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("goal bonded must be set")
+	}
+	if !v.IsPositive() || !v.LT(sdk.OneDec()) {
+		return fmt.Errorf("goal bonded must be between 0 and 1 exclusive: %s", v)
+	}
+	return nil
+}
+
+func validateEmissionFactorMin(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min emission factor must be set")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min emission factor must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateEmissionFactorMax(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max emission factor must be set")
+	}
+	if v.LT(sdk.OneDec()) {
+		return fmt.Errorf("max emission factor cannot be less than 1: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package ante
+
+import (
+	"github.com/CudoVentures/cudos-node/x/feeabs/keeper"
+	"github.com/CudoVentures/cudos-node/x/feeabs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MempoolFeeDecorator is the auth MempoolFeeDecorator with the fees paid in
+// accepted fee tokens valued in acudos before they are compared to the
+// validator's minimum gas prices. Fees in a fee token the module reserve can
+// not convert are rejected before they reach the mempool.
+// Note this only applies when ctx.CheckTx = true
+type MempoolFeeDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewMempoolFeeDecorator(k keeper.Keeper) MempoolFeeDecorator {
+	return MempoolFeeDecorator{keeper: k}
+}
+
+func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	gas := feeTx.GetGas()
+
+	if ctx.IsCheckTx() {
+		for _, fee := range feeTx.GetFee() {
+			if !mfd.keeper.IsFeeToken(ctx, fee.Denom) {
+				continue
+			}
+			if _, err := mfd.keeper.CheckReserve(ctx, fee); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	if ctx.IsCheckTx() && !simulate {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+
+			// Determine the required fees by multiplying each required minimum gas
+			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+			glDec := sdk.NewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			feeCoins := mfd.keeper.NativeEquivalent(ctx, feeTx.GetFee())
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s (%s) required: %s", feeTx.GetFee(), feeCoins, requiredFees)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// DeductFeeDecorator deducts fees paid in an accepted fee token from the fee
+// payer, converts them to acudos and sends them to the fee collector. Fees
+// paid in any other denom are handled by the auth DeductFeeDecorator.
+type DeductFeeDecorator struct {
+	ak             authante.AccountKeeper
+	feegrantKeeper authante.FeegrantKeeper
+	keeper         keeper.Keeper
+	native         authante.DeductFeeDecorator
+}
+
+func NewDeductFeeDecorator(ak authante.AccountKeeper, bk authtypes.BankKeeper, fk authante.FeegrantKeeper, k keeper.Keeper) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		ak:             ak,
+		feegrantKeeper: fk,
+		keeper:         k,
+		native:         authante.NewDeductFeeDecorator(ak, bk, fk),
+	}
+}
+
+func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+
+	usesFeeToken := false
+	for _, coin := range fee {
+		if dfd.keeper.IsFeeToken(ctx, coin.Denom) {
+			usesFeeToken = true
+		}
+	}
+
+	if !usesFeeToken {
+		return dfd.native.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if len(fee) != 1 {
+		return ctx, sdkerrors.Wrapf(types.ErrMultipleFeeDenoms, "fee %s", fee)
+	}
+
+	if _, err := dfd.keeper.CheckReserve(ctx, fee[0]); err != nil {
+		return ctx, err
+	}
+
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFrom := feePayer
+
+	// if feegranter set deduct fee from feegranter account.
+	// this works with only when feegrant enabled.
+	if feeGranter != nil {
+		if dfd.feegrantKeeper == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		} else if !feeGranter.Equals(feePayer) {
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+
+			if err != nil {
+				return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
+			}
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	if dfd.ak.GetAccount(ctx, deductFeesFrom) == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	if !fee.IsValid() {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fee)
+	}
+
+	if _, err := dfd.keeper.ConvertFee(ctx, deductFeesFrom, fee[0]); err != nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	events := sdk.Events{sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+	)}
+	ctx.EventManager().EmitEvents(events)
+
+	return next(ctx, tx, simulate)
+}
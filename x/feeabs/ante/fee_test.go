@@ -0,0 +1,219 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/feeabs/ante"
+	"github.com/CudoVentures/cudos-node/x/feeabs/keeper"
+	"github.com/CudoVentures/cudos-node/x/feeabs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const feeDenom = "gravity0x817bbDbC3e8A1204f3691d14bB44992841e3dB35"
+
+var (
+	payer   = sdk.AccAddress([]byte("payer_______________"))
+	granter = sdk.AccAddress([]byte("granter_____________"))
+
+	reserve      = authtypes.NewModuleAddress(types.ModuleName)
+	feeCollector = authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+)
+
+type mockAdminKeeper struct{}
+
+func (mockAdminKeeper) IsAdmin(sdk.Context, sdk.AccAddress) bool { return false }
+
+// mockAccountKeeper knows the payer and the granter only.
+type mockAccountKeeper struct {
+	keepertest.AccountKeeper
+}
+
+func (mockAccountKeeper) GetParams(sdk.Context) authtypes.Params { return authtypes.DefaultParams() }
+
+func (mockAccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	if !addr.Equals(payer) && !addr.Equals(granter) {
+		return nil
+	}
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (mockAccountKeeper) SetAccount(sdk.Context, authtypes.AccountI) {}
+
+// mockFeegrantKeeper lets the granter pay the fees of the payer.
+type mockFeegrantKeeper struct{}
+
+func (mockFeegrantKeeper) UseGrantedFees(ctx sdk.Context, feeGranter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	if !feeGranter.Equals(granter) || !grantee.Equals(payer) {
+		return sdkerrors.ErrUnauthorized
+	}
+	return nil
+}
+
+type mockFeeTx struct {
+	fee     sdk.Coins
+	gas     uint64
+	granter sdk.AccAddress
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg         { return nil }
+func (tx mockFeeTx) ValidateBasic() error       { return nil }
+func (tx mockFeeTx) GetGas() uint64             { return tx.gas }
+func (tx mockFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress   { return payer }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress { return tx.granter }
+
+func setupKeeper(t *testing.T) (keeper.Keeper, *keepertest.BankKeeper, sdk.Context) {
+	stores := keepertest.NewStores()
+	bk := keepertest.NewBankKeeper()
+	k := keeper.NewKeeper(stores.Codec, stores.KVStoreKey(types.StoreKey), stores.Subspace(types.ModuleName), keepertest.AccountKeeper{}, bk, bk, mockAdminKeeper{}, authtypes.FeeCollectorName)
+
+	ctx := stores.Context(t, tmproto.Header{})
+	k.SetParams(ctx, types.NewParams([]types.FeeToken{{Denom: feeDenom, Rate: sdk.NewDec(2)}}, types.DefaultTwapWindow))
+
+	return *k, bk, ctx
+}
+
+func nextHandler(called *bool) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		*called = true
+		return ctx, nil
+	}
+}
+
+func TestMempoolFeeDecorator(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		checkTx  bool
+		fee      sdk.Coins
+		reserve  int64
+		simulate bool
+		err      error
+	}{
+		{name: "native fee", checkTx: true, fee: sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 1000))},
+		{name: "native fee too low", checkTx: true, fee: sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 999)), err: sdkerrors.ErrInsufficientFee},
+		// 500 fee tokens are worth 1000acudos
+		{name: "fee token", checkTx: true, fee: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 500)), reserve: 1000},
+		{name: "fee token too low", checkTx: true, fee: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 499)), reserve: 1000, err: sdkerrors.ErrInsufficientFee},
+		{name: "fee token with an empty reserve", checkTx: true, fee: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 500)), err: types.ErrInsufficientFunds},
+		{name: "fee token above the reserve", checkTx: true, fee: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 500)), reserve: 999, err: types.ErrInsufficientFunds},
+		{name: "simulated fee token with an empty reserve", checkTx: true, fee: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 500)), simulate: true, err: types.ErrInsufficientFunds},
+		{name: "unknown denom", checkTx: true, fee: sdk.NewCoins(sdk.NewInt64Coin("other", 1000)), err: sdkerrors.ErrInsufficientFee},
+		{name: "simulation", checkTx: true, fee: sdk.NewCoins(), simulate: true},
+		{name: "deliver tx", fee: sdk.NewCoins()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k, bk, ctx := setupKeeper(t)
+			ctx = ctx.WithIsCheckTx(tc.checkTx).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(types.NativeDenom, 10)))
+			bk.Balances[reserve.String()] = sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, tc.reserve))
+
+			decorator := ante.NewMempoolFeeDecorator(k)
+			called := false
+			_, err := decorator.AnteHandle(ctx, mockFeeTx{fee: tc.fee, gas: 100}, tc.simulate, nextHandler(&called))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.False(t, called)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, called)
+		})
+	}
+}
+
+func TestDeductFeeDecorator(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		fee     sdk.Coins
+		granter sdk.AccAddress
+		reserve int64
+		err     error
+		// balances after the decorator ran
+		payer         sdk.Coins
+		feeCollector  sdk.Coins
+		communityPool sdk.Coins
+	}{
+		{
+			name:         "native fee",
+			fee:          sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 100)),
+			payer:        sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 900), sdk.NewInt64Coin(feeDenom, 1000)),
+			feeCollector: sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 100)),
+		},
+		{
+			name:          "fee token",
+			fee:           sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)),
+			reserve:       1000,
+			payer:         sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 1000), sdk.NewInt64Coin(feeDenom, 900)),
+			feeCollector:  sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 200)),
+			communityPool: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)),
+		},
+		{
+			name:          "fee token paid by the granter",
+			fee:           sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)),
+			granter:       granter,
+			reserve:       1000,
+			payer:         sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 1000), sdk.NewInt64Coin(feeDenom, 1000)),
+			feeCollector:  sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 200)),
+			communityPool: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)),
+		},
+		{
+			name:    "reserve too low",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)),
+			reserve: 199,
+			err:     types.ErrInsufficientFunds,
+		},
+		{
+			name: "empty reserve",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)),
+			err:  types.ErrInsufficientFunds,
+		},
+		{
+			name:    "empty reserve with a granter",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)),
+			granter: granter,
+			err:     types.ErrInsufficientFunds,
+		},
+		{
+			name:    "fee token above the balance",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1001)),
+			reserve: 10000,
+			err:     sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name:    "fee token next to another denom",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 100), sdk.NewInt64Coin(feeDenom, 100)),
+			reserve: 1000,
+			err:     types.ErrMultipleFeeDenoms,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k, bk, ctx := setupKeeper(t)
+			balance := sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 1000), sdk.NewInt64Coin(feeDenom, 1000))
+			bk.Balances[payer.String()] = balance
+			bk.Balances[granter.String()] = balance
+			bk.Balances[reserve.String()] = sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, tc.reserve))
+
+			decorator := ante.NewDeductFeeDecorator(mockAccountKeeper{}, bk, mockFeegrantKeeper{}, k)
+			called := false
+			_, err := decorator.AnteHandle(ctx, mockFeeTx{fee: tc.fee, granter: tc.granter}, false, nextHandler(&called))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.False(t, called)
+				require.Equal(t, balance, bk.Balances[payer.String()])
+				require.True(t, bk.Balances[feeCollector.String()].IsZero())
+				require.True(t, bk.CommunityPool.IsZero())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, called)
+			require.Equal(t, tc.payer, bk.Balances[payer.String()])
+			require.Equal(t, tc.feeCollector, bk.Balances[feeCollector.String()])
+			require.Equal(t, tc.communityPool, bk.CommunityPool)
+		})
+	}
+}
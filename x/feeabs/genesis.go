@@ -0,0 +1,28 @@
+package feeabs
+
+import (
+	"github.com/CudoVentures/cudos-node/x/feeabs/keeper"
+	"github.com/CudoVentures/cudos-node/x/feeabs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the feeabs module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	for _, observation := range data.Observations {
+		k.SetObservation(ctx, observation)
+	}
+}
+
+// ExportGenesis returns the feeabs module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	observations := []types.PriceObservation{}
+	k.IterateObservations(ctx, func(observation types.PriceObservation) bool {
+		observations = append(observations, observation)
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), observations)
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/CudoVentures/cudos-node/x/feeabs/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+type (
+	Keeper struct {
+		cdc              codec.Codec
+		storeKey         sdk.StoreKey
+		paramSpace       paramtypes.Subspace
+		accountKeeper    types.AccountKeeper
+		bankKeeper       types.BankKeeper
+		distrKeeper      types.DistributionKeeper
+		adminKeeper      types.AdminKeeper
+		feeCollectorName string
+	}
+)
+
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	dk types.DistributionKeeper,
+	adk types.AdminKeeper,
+	feeCollectorName string,
+) *Keeper {
+	// ensure feeabs module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the feeabs module account has not been set")
+	}
+
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		cdc:              cdc,
+		storeKey:         storeKey,
+		paramSpace:       paramSpace,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		distrKeeper:      dk,
+		adminKeeper:      adk,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of feeabs parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feeabs parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetReserve returns the acudos held by the module to pay for converted fees.
+func (k Keeper) GetReserve(ctx sdk.Context) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), types.NativeDenom)
+}
+
+// GetRate returns the amount of acudos paid for one unit of the given fee
+// token, either fixed by governance or derived from the recorded prices.
+func (k Keeper) GetRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	params := k.GetParams(ctx)
+
+	token, found := params.FeeToken(denom)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrUnknownFeeToken, "%s", denom)
+	}
+
+	if !token.UseTwap {
+		return token.Rate, nil
+	}
+
+	return k.GetTwap(ctx, denom, params.TwapWindow)
+}
+
+// ConvertToNative returns the acudos value of the given fee token amount,
+// rounded down.
+func (k Keeper) ConvertToNative(ctx sdk.Context, fee sdk.Coin) (sdk.Coin, error) {
+	rate, err := k.GetRate(ctx, fee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(types.NativeDenom, fee.Amount.ToDec().Mul(rate).TruncateInt()), nil
+}
+
+// CheckReserve returns the acudos value of the given fee token amount, or
+// ErrInsufficientFunds if the module reserve can not pay it. Fees in a fee
+// token are rejected up front with this error once the reserve runs dry.
+func (k Keeper) CheckReserve(ctx sdk.Context, fee sdk.Coin) (sdk.Coin, error) {
+	native, err := k.ConvertToNative(ctx, fee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if reserve := k.GetReserve(ctx); reserve.IsLT(native) {
+		k.Logger(ctx).Error("fee reserve too low to convert fees", "reserve", reserve, "fee", fee, "needed", native)
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInsufficientFunds, "the reserve holds %s but the fee %s is worth %s, pay the fee in %s", reserve, fee, native, types.NativeDenom)
+	}
+
+	return native, nil
+}
+
+// ConvertFee pays the fee token of the payer to the community pool and sends
+// its acudos value from the module reserve to the fee collector, so the fee is
+// distributed as if it had been paid in acudos. Governance spends the fee
+// tokens out of the community pool, for instance to swap them for acudos and
+// top up the reserve.
+func (k Keeper) ConvertFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error) {
+	native, err := k.CheckReserve(ctx, fee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), payer); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, sdk.NewCoins(native)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertFee,
+			sdk.NewAttribute(types.AttributeKeyPayer, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyConverted, native.String()),
+		),
+	)
+
+	return native, nil
+}
+
+// NativeEquivalent returns the fees with every accepted fee token replaced by
+// its acudos value. Coins that can not be converted are kept as they are.
+func (k Keeper) NativeEquivalent(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	params := k.GetParams(ctx)
+
+	equivalent := sdk.NewCoins()
+	for _, fee := range fees {
+		if _, found := params.FeeToken(fee.Denom); found {
+			if native, err := k.ConvertToNative(ctx, fee); err == nil {
+				equivalent = equivalent.Add(native)
+				continue
+			}
+		}
+		equivalent = equivalent.Add(fee)
+	}
+
+	return equivalent
+}
+
+// IsFeeToken reports whether the denom is accepted for paying fees.
+func (k Keeper) IsFeeToken(ctx sdk.Context, denom string) bool {
+	_, found := k.GetParams(ctx).FeeToken(denom)
+	return found
+}
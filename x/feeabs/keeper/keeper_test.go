@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/feeabs/keeper"
+	"github.com/CudoVentures/cudos-node/x/feeabs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+type mockAdminKeeper struct{}
+
+func (mockAdminKeeper) IsAdmin(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return false
+}
+
+func setupKeeper(t *testing.T) (keeper.Keeper, *keepertest.BankKeeper, sdk.Context) {
+	stores := keepertest.NewStores()
+	storeKey := stores.KVStoreKey(types.StoreKey)
+	subspace := stores.Subspace(types.ModuleName)
+
+	bk := keepertest.NewBankKeeper()
+	k := keeper.NewKeeper(stores.Codec, storeKey, subspace, keepertest.AccountKeeper{}, bk, bk, mockAdminKeeper{}, authtypes.FeeCollectorName)
+
+	ctx := stores.Context(t, tmproto.Header{Time: time.Unix(1000000, 0)})
+	k.SetParams(ctx, types.NewParams([]types.FeeToken{
+		{Denom: "gravity0x817bbDbC3e8A1204f3691d14bB44992841e3dB35", Rate: sdk.NewDec(2)},
+		{Denom: ibcDenom, Rate: sdk.ZeroDec(), UseTwap: true},
+	}, 100))
+
+	return *k, bk, ctx
+}
+
+func TestGetTwap(t *testing.T) {
+	k, _, ctx := setupKeeper(t)
+
+	_, err := k.GetRate(ctx, ibcDenom)
+	require.ErrorIs(t, err, types.ErrNoPrice)
+
+	start := ctx.BlockTime()
+	require.NoError(t, k.RecordPrice(ctx, ibcDenom, sdk.NewDec(10)))
+
+	ctx = ctx.WithBlockTime(start.Add(60 * time.Second))
+	require.NoError(t, k.RecordPrice(ctx, ibcDenom, sdk.NewDec(20)))
+
+	// 60s at 10 and 40s at 20
+	ctx = ctx.WithBlockTime(start.Add(100 * time.Second))
+	rate, err := k.GetRate(ctx, ibcDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(14), rate)
+
+	// the first observation leaves the window, 100s at 20
+	ctx = ctx.WithBlockTime(start.Add(160 * time.Second))
+	require.NoError(t, k.RecordPrice(ctx, ibcDenom, sdk.NewDec(20)))
+	rate, err = k.GetRate(ctx, ibcDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(20), rate)
+	require.Len(t, k.GetObservations(ctx, ibcDenom), 2)
+
+	require.ErrorIs(t, k.RecordPrice(ctx, "unknown", sdk.NewDec(1)), types.ErrUnknownFeeToken)
+}
+
+func TestConvertFee(t *testing.T) {
+	k, bk, ctx := setupKeeper(t)
+	payer := sdk.AccAddress("payer_______________")
+	denom := "gravity0x817bbDbC3e8A1204f3691d14bB44992841e3dB35"
+
+	bk.Balances[payer.String()] = sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	_, err := k.ConvertFee(ctx, payer, sdk.NewInt64Coin(denom, 50))
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), bk.Balances[payer.String()])
+	require.True(t, bk.CommunityPool.IsZero())
+
+	bk.Balances[authtypes.NewModuleAddress(types.ModuleName).String()] = sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 1000))
+
+	native, err := k.ConvertFee(ctx, payer, sdk.NewInt64Coin(denom, 50))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(types.NativeDenom, 100), native)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 50)), bk.Balances[payer.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 50)), bk.CommunityPool)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 100)), bk.Balances[authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()])
+	require.Equal(t, sdk.NewInt64Coin(types.NativeDenom, 900), k.GetReserve(ctx))
+
+	equivalent := k.NativeEquivalent(ctx, sdk.NewCoins(sdk.NewInt64Coin(denom, 3), sdk.NewInt64Coin("stake", 1)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.NativeDenom, 6), sdk.NewInt64Coin("stake", 1)), equivalent)
+}
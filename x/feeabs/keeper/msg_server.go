@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	admintypes "github.com/CudoVentures/cudos-node/x/admin/types"
+	"github.com/CudoVentures/cudos-node/x/feeabs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+func (m msgServer) RecordPrice(goCtx context.Context, msg *types.MsgRecordPrice) (*types.MsgRecordPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	initiatorAddr, err := sdk.AccAddressFromBech32(msg.Initiator)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.Keeper.adminKeeper.IsAdmin(ctx, initiatorAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "Insufficient permissions. Address '%s' has no %s tokens and is not a group policy controlled by admins", initiatorAddr, admintypes.AdminDenom)
+	}
+
+	if err := m.Keeper.RecordPrice(ctx, msg.Denom, msg.Rate); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRecordPriceResponse{}, nil
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"github.com/CudoVentures/cudos-node/x/feeabs/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetObservation stores a price observation.
+func (k Keeper) SetObservation(ctx sdk.Context, observation types.PriceObservation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ObservationKey(observation.Denom, observation.Time), k.cdc.MustMarshal(&observation))
+}
+
+// GetObservations returns the observations of a denom, oldest first.
+func (k Keeper) GetObservations(ctx sdk.Context, denom string) []types.PriceObservation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ObservationsKey(denom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var observations []types.PriceObservation
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		observations = append(observations, observation)
+	}
+
+	return observations
+}
+
+// IterateObservations iterates over the observations of all denoms.
+func (k Keeper) IterateObservations(ctx sdk.Context, cb func(observation types.PriceObservation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ObservationKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		if cb(observation) {
+			break
+		}
+	}
+}
+
+// RecordPrice stores a price observation for a fee token at the current block
+// time and prunes the observations that no longer affect the average.
+func (k Keeper) RecordPrice(ctx sdk.Context, denom string, rate sdk.Dec) error {
+	params := k.GetParams(ctx)
+	if _, found := params.FeeToken(denom); !found {
+		return sdkerrors.Wrapf(types.ErrUnknownFeeToken, "%s", denom)
+	}
+
+	now := ctx.BlockTime().Unix()
+	k.SetObservation(ctx, types.PriceObservation{Denom: denom, Rate: rate, Time: now})
+	k.pruneObservations(ctx, denom, now-int64(params.TwapWindow))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordPrice,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyRate, rate.String()),
+		),
+	)
+
+	return nil
+}
+
+// pruneObservations deletes every observation older than the window start
+// except the newest of them, which is still the price at the window start.
+func (k Keeper) pruneObservations(ctx sdk.Context, denom string, windowStart int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	var stale []types.PriceObservation
+	for _, observation := range k.GetObservations(ctx, denom) {
+		if observation.Time > windowStart {
+			break
+		}
+		stale = append(stale, observation)
+	}
+
+	for i := 0; i < len(stale)-1; i++ {
+		store.Delete(types.ObservationKey(denom, stale[i].Time))
+	}
+}
+
+// GetTwap returns the time weighted average of the recorded prices of a denom
+// over the last window seconds. Every observation is the price until the next
+// one is recorded.
+func (k Keeper) GetTwap(ctx sdk.Context, denom string, window uint64) (sdk.Dec, error) {
+	observations := k.GetObservations(ctx, denom)
+	if len(observations) == 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoPrice, "%s", denom)
+	}
+
+	now := ctx.BlockTime().Unix()
+	windowStart := now - int64(window)
+
+	weighted := sdk.ZeroDec()
+	duration := int64(0)
+	for i, observation := range observations {
+		end := now
+		if i+1 < len(observations) {
+			end = observations[i+1].Time
+		}
+
+		start := observation.Time
+		if start < windowStart {
+			start = windowStart
+		}
+
+		if end <= start {
+			continue
+		}
+
+		weighted = weighted.Add(observation.Rate.MulInt64(end - start))
+		duration += end - start
+	}
+
+	if duration == 0 {
+		return observations[len(observations)-1].Rate, nil
+	}
+
+	return weighted.QuoInt64(duration), nil
+}
@@ -0,0 +1,15 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feeabs module sentinel errors
+var (
+	ErrUnknownFeeToken   = sdkerrors.Register(ModuleName, 1100, "unknown fee token")
+	ErrNoPrice           = sdkerrors.Register(ModuleName, 1101, "no price available")
+	ErrInsufficientFunds = sdkerrors.Register(ModuleName, 1102, "insufficient acudos in the fee reserve")
+	ErrMultipleFeeDenoms = sdkerrors.Register(ModuleName, 1103, "non-native fees must be paid in a single denom")
+)
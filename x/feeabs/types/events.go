@@ -0,0 +1,13 @@
+package types
+
+// feeabs module event types
+const (
+	EventTypeConvertFee  = "convert_fee"
+	EventTypeRecordPrice = "record_price"
+
+	AttributeKeyPayer     = "payer"
+	AttributeKeyFee       = "fee"
+	AttributeKeyConverted = "converted"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyRate      = "rate"
+)
@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, observations []PriceObservation) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		Observations: observations,
+	}
+}
+
+// DefaultGenesis returns the default feeabs genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:       DefaultParams(),
+		Observations: []PriceObservation{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, o := range gs.Observations {
+		if _, ok := gs.Params.FeeToken(o.Denom); !ok {
+			return fmt.Errorf("price observation for unknown fee token: %s", o.Denom)
+		}
+		if o.Rate.IsNil() || !o.Rate.IsPositive() {
+			return fmt.Errorf("price observation for %s must have a positive rate", o.Denom)
+		}
+	}
+
+	return nil
+}
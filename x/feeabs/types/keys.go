@@ -0,0 +1,36 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeabs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for feeabs
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// NativeDenom is the denom non-native fees are converted to
+	NativeDenom = "acudos"
+)
+
+// ObservationKeyPrefix prefixes the price observations, which are stored
+// under ObservationKeyPrefix | len(denom) | denom | big endian unix time.
+var ObservationKeyPrefix = []byte{0x01}
+
+// ObservationsKey returns the prefix of all observations of the given denom.
+func ObservationsKey(denom string) []byte {
+	key := append([]byte{}, ObservationKeyPrefix...)
+	key = append(key, byte(len(denom)))
+	return append(key, []byte(denom)...)
+}
+
+// ObservationKey returns the key of the observation of the given denom at the given time.
+func ObservationKey(denom string, time int64) []byte {
+	return append(ObservationsKey(denom), sdk.Uint64ToBigEndian(uint64(time))...)
+}
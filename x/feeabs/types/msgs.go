@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRecordPrice = "recordPrice"
+
+var _ sdk.Msg = &MsgRecordPrice{}
+
+func NewMsgRecordPrice(initiator sdk.AccAddress, denom string, rate sdk.Dec) *MsgRecordPrice {
+	return &MsgRecordPrice{Initiator: initiator.String(), Denom: denom, Rate: rate}
+}
+
+// Route Implements Msg.
+func (msg MsgRecordPrice) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRecordPrice) Type() string { return TypeMsgRecordPrice }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRecordPrice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Initiator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid initiator address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if msg.Rate.IsNil() || !msg.Rate.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "rate must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRecordPrice) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRecordPrice) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Initiator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
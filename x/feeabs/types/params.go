@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyFeeTokens  = []byte("FeeTokens")
+	KeyTwapWindow = []byte("TwapWindow")
+)
+
+// DefaultTwapWindow is one hour
+const DefaultTwapWindow uint64 = 3600
+
+// ParamKeyTable ParamTable for feeabs module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(feeTokens []FeeToken, twapWindow uint64) Params {
+	return Params{
+		FeeTokens:  feeTokens,
+		TwapWindow: twapWindow,
+	}
+}
+
+// DefaultParams default feeabs module parameters
+func DefaultParams() Params {
+	return Params{
+		FeeTokens:  []FeeToken{},
+		TwapWindow: DefaultTwapWindow,
+	}
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
+	return validateTwapWindow(p.TwapWindow)
+}
+
+// FeeToken returns the fee token with the given denom, if it is accepted.
+func (p Params) FeeToken(denom string) (FeeToken, bool) {
+	for _, token := range p.FeeTokens {
+		if token.Denom == denom {
+			return token, true
+		}
+	}
+
+	return FeeToken{}, false
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeTokens, &p.FeeTokens, validateFeeTokens),
+		paramtypes.NewParamSetPair(KeyTwapWindow, &p.TwapWindow, validateTwapWindow),
+	}
+}
+
+func validateFeeTokens(i interface{}) error {
+	v, ok := i.([]FeeToken)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, token := range v {
+		if err := sdk.ValidateDenom(token.Denom); err != nil {
+			return err
+		}
+		if token.Denom == NativeDenom {
+			return fmt.Errorf("%s can not be a fee token", NativeDenom)
+		}
+		if seen[token.Denom] {
+			return fmt.Errorf("duplicate fee token: %s", token.Denom)
+		}
+		seen[token.Denom] = true
+
+		if token.Rate.IsNil() || token.Rate.IsNegative() {
+			return fmt.Errorf("fee token %s rate must not be negative", token.Denom)
+		}
+		if !token.UseTwap && !token.Rate.IsPositive() {
+			return fmt.Errorf("fee token %s needs a positive rate when it does not use twap", token.Denom)
+		}
+	}
+
+	return nil
+}
+
+func validateTwapWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("twap window must be positive: %d", v)
+	}
+	return nil
+}
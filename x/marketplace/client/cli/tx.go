@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/CudoVentures/cudos-node/x/marketplace/types"
+)
+
+const (
+	FlagRecipient = "recipient"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdListNFT(),
+		CmdBuyNFT(),
+		CmdCancelListing(),
+		CmdSetRoyalty(),
+	)
+
+	return cmd
+}
+
+func CmdListNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [denom-id] [token-id] [price]",
+		Short: "List an nft for sale, moving it to the marketplace escrow",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace list artworks a1 1000000000000000000acudos --from mykey",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgListNFT(clientCtx.GetFromAddress(), args[0], args[1], price)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBuyNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy [listing-id]",
+		Short: "Buy a listed nft at its price",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			listingID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyNFT(clientCtx.GetFromAddress(), listingID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelListing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [listing-id]",
+		Short: "Cancel a listing, returning the nft to the seller",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			listingID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelListing(clientCtx.GetFromAddress(), listingID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-royalty [denom-id] [bps]",
+		Short: "Set the royalty paid on every sale of a denom created by the sender",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace set-royalty artworks 250 --recipient cudos1... --from mykey",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bps, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			recipient := clientCtx.GetFromAddress()
+			if recipientStr, _ := cmd.Flags().GetString(FlagRecipient); recipientStr != "" {
+				if recipient, err = sdk.AccAddressFromBech32(recipientStr); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetRoyalty(clientCtx.GetFromAddress(), args[0], recipient, uint32(bps))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRecipient, "", "Recipient of the royalty, defaults to the sender")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
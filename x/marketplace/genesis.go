@@ -0,0 +1,44 @@
+package marketplace
+
+import (
+	"github.com/CudoVentures/cudos-node/x/marketplace/keeper"
+	"github.com/CudoVentures/cudos-node/x/marketplace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the marketplace module's state from a provided
+// genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, data types.GenesisState) {
+	// create the escrow account if it does not exist yet
+	ak.GetModuleAccount(ctx, types.ModuleName)
+
+	k.SetParams(ctx, data.Params)
+	k.SetNextListingID(ctx, data.NextListingId)
+
+	for _, listing := range data.Listings {
+		k.SetListing(ctx, listing)
+	}
+
+	for _, royalty := range data.Royalties {
+		if err := k.SetRoyalty(ctx, royalty); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the marketplace module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	listings := []types.Listing{}
+	k.IterateListings(ctx, func(listing types.Listing) bool {
+		listings = append(listings, listing)
+		return false
+	})
+
+	royalties := []types.Royalty{}
+	k.IterateRoyalties(ctx, func(royalty types.Royalty) bool {
+		royalties = append(royalties, royalty)
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), listings, royalties, k.GetNextListingID(ctx))
+}
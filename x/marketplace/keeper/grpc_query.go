@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/CudoVentures/cudos-node/x/marketplace/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) Listing(goCtx context.Context, req *types.QueryListingRequest) (*types.QueryListingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	listing, err := k.GetListing(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryListingResponse{Listing: listing}, nil
+}
+
+func (k Keeper) Listings(goCtx context.Context, req *types.QueryListingsRequest) (*types.QueryListingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	listings := []types.Listing{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ListingKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var listing types.Listing
+		if err := k.cdc.Unmarshal(value, &listing); err != nil {
+			return err
+		}
+		listings = append(listings, listing)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListingsResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ListingsByCollection(goCtx context.Context, req *types.QueryListingsByCollectionRequest) (*types.QueryListingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return k.paginateIndex(ctx, types.CollectionListingsKey(req.DenomId), req.Pagination)
+}
+
+func (k Keeper) ListingsByOwner(goCtx context.Context, req *types.QueryListingsByOwnerRequest) (*types.QueryListingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", req.Owner)
+	}
+
+	return k.paginateIndex(ctx, types.OwnerListingsKey(owner), req.Pagination)
+}
+
+func (k Keeper) Royalty(goCtx context.Context, req *types.QueryRoyaltyRequest) (*types.QueryRoyaltyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	royalty, found := k.GetRoyalty(ctx, req.DenomId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no royalty set for denom %s", req.DenomId)
+	}
+
+	return &types.QueryRoyaltyResponse{Royalty: royalty}, nil
+}
+
+// paginateIndex returns the listings of an index whose keys end with the
+// listing id.
+func (k Keeper) paginateIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) (*types.QueryListingsResponse, error) {
+	listings := []types.Listing{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		listing, err := k.GetListing(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		listings = append(listings, listing)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListingsResponse{Listings: listings, Pagination: pageRes}, nil
+}
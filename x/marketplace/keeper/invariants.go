@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/CudoVentures/cudos-node/x/marketplace/types"
+	nfttypes "github.com/CudoVentures/cudos-node/x/nft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the marketplace module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow", EscrowInvariant(k))
+}
+
+// EscrowInvariant checks that the escrow holds the nft of every listing and
+// no other nft.
+func EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		escrow := k.EscrowAddress()
+
+		var listings uint64
+		k.IterateListings(ctx, func(listing types.Listing) bool {
+			listings++
+			nft, err := k.nftKeeper.GetNFT(ctx, listing.DenomId, listing.TokenId)
+			if err != nil || nft.Owner != escrow.String() {
+				broken = true
+				msg += fmt.Sprintf("\tthe nft %s/%s of listing %d is not in escrow\n", listing.DenomId, listing.TokenId, listing.Id)
+			}
+			return false
+		})
+
+		var escrowed uint64
+		k.nftKeeper.IterateDenoms(ctx, func(denom nfttypes.Denom) bool {
+			escrowed += k.nftKeeper.GetOwnerSupply(ctx, denom.Id, escrow)
+			return false
+		})
+
+		if escrowed != listings {
+			broken = true
+			msg += fmt.Sprintf("\tthe escrow holds %d nfts for %d listings\n", escrowed, listings)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow", msg), broken
+	}
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/CudoVentures/cudos-node/x/marketplace/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+type (
+	Keeper struct {
+		cdc           codec.Codec
+		storeKey      sdk.StoreKey
+		paramSpace    paramtypes.Subspace
+		accountKeeper types.AccountKeeper
+		bankKeeper    types.BankKeeper
+		distrKeeper   types.DistributionKeeper
+		nftKeeper     types.NftKeeper
+	}
+)
+
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	dk types.DistributionKeeper,
+	nk types.NftKeeper,
+) *Keeper {
+	// ensure marketplace module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the marketplace module account has not been set")
+	}
+
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSpace:    paramSpace,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+		nftKeeper:     nk,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of marketplace parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of marketplace parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// EscrowAddress returns the address holding the listed nfts.
+func (k Keeper) EscrowAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ModuleName)
+}
+
+// GetNextListingID returns the id of the next listing.
+func (k Keeper) GetNextListingID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextListingIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextListingID sets the id of the next listing.
+func (k Keeper) SetNextListingID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextListingIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetRoyalty returns the royalty of the denom.
+func (k Keeper) GetRoyalty(ctx sdk.Context, denomID string) (types.Royalty, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RoyaltyKey(denomID))
+	if bz == nil {
+		return types.Royalty{}, false
+	}
+
+	var royalty types.Royalty
+	k.cdc.MustUnmarshal(bz, &royalty)
+	return royalty, true
+}
+
+// SetRoyalty sets the royalty of a denom, which must not exceed the max
+// royalty param.
+func (k Keeper) SetRoyalty(ctx sdk.Context, royalty types.Royalty) error {
+	if maxBps := k.GetParams(ctx).MaxRoyaltyBps; royalty.Bps > maxBps {
+		return sdkerrors.Wrapf(types.ErrInvalidRoyalty, "royalty of %d bps exceeds %d bps", royalty.Bps, maxBps)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.RoyaltyKey(royalty.DenomId), k.cdc.MustMarshal(&royalty))
+	return nil
+}
+
+// IterateRoyalties iterates over the royalties until cb returns true.
+func (k Keeper) IterateRoyalties(ctx sdk.Context, cb func(royalty types.Royalty) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RoyaltyKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var royalty types.Royalty
+		k.cdc.MustUnmarshal(iterator.Value(), &royalty)
+		if cb(royalty) {
+			break
+		}
+	}
+}
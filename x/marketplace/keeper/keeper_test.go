@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/marketplace/keeper"
+	"github.com/CudoVentures/cudos-node/x/marketplace/types"
+	nftkeeper "github.com/CudoVentures/cudos-node/x/nft/keeper"
+	nfttypes "github.com/CudoVentures/cudos-node/x/nft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	creator = sdk.AccAddress([]byte("creator_____________"))
+	alice   = sdk.AccAddress([]byte("alice_______________"))
+	bob     = sdk.AccAddress([]byte("bob_________________"))
+	artist  = sdk.AccAddress([]byte("artist______________"))
+)
+
+func setupKeeper(t *testing.T) (keeper.Keeper, nftkeeper.Keeper, *keepertest.BankKeeper, sdk.Context) {
+	stores := keepertest.NewStores()
+	storeKey := stores.KVStoreKey(types.StoreKey)
+	nftStoreKey := stores.KVStoreKey(nfttypes.StoreKey)
+	subspace := stores.Subspace(types.ModuleName)
+
+	bk := keepertest.NewBankKeeper()
+	nk := nftkeeper.NewKeeper(stores.Codec, nftStoreKey)
+	k := keeper.NewKeeper(stores.Codec, storeKey, subspace, keepertest.AccountKeeper{}, bk, bk, nk)
+
+	ctx := stores.Context(t, tmproto.Header{})
+	k.SetParams(ctx, types.DefaultParams())
+
+	require.NoError(t, nk.IssueDenom(ctx, nfttypes.Denom{Id: "artworks", Name: "Artworks", Creator: creator.String()}))
+	for _, id := range []string{"a1", "a2", "a3"} {
+		require.NoError(t, nk.MintNFT(ctx, "artworks", nfttypes.BaseNFT{Id: id, Owner: alice.String()}))
+	}
+
+	return *k, *nk, bk, ctx
+}
+
+func requireInvariants(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+	keepertest.RequireInvariants(t, ctx, keeper.EscrowInvariant(k))
+}
+
+func acudos(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin("acudos", amount)
+}
+
+func TestListAndCancel(t *testing.T) {
+	k, nk, _, ctx := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// only the owner lists
+	_, err := srv.ListNFT(goCtx, types.NewMsgListNFT(bob, "artworks", "a1", acudos(1000)))
+	require.Error(t, err)
+
+	res, err := srv.ListNFT(goCtx, types.NewMsgListNFT(alice, "artworks", "a1", acudos(1000)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.ListingId)
+
+	nft, err := nk.GetNFT(ctx, "artworks", "a1")
+	require.NoError(t, err)
+	require.Equal(t, k.EscrowAddress().String(), nft.Owner)
+
+	// an escrowed nft cannot be listed twice
+	_, err = srv.ListNFT(goCtx, types.NewMsgListNFT(alice, "artworks", "a1", acudos(1000)))
+	require.Error(t, err)
+	requireInvariants(t, k, ctx)
+
+	byOwner, err := k.ListingsByOwner(goCtx, &types.QueryListingsByOwnerRequest{Owner: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, byOwner.Listings, 1)
+	byCollection, err := k.ListingsByCollection(goCtx, &types.QueryListingsByCollectionRequest{DenomId: "artworks"})
+	require.NoError(t, err)
+	require.Len(t, byCollection.Listings, 1)
+
+	// only the seller cancels
+	_, err = srv.CancelListing(goCtx, types.NewMsgCancelListing(bob, 1))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.CancelListing(goCtx, types.NewMsgCancelListing(alice, 1))
+	require.NoError(t, err)
+
+	nft, err = nk.GetNFT(ctx, "artworks", "a1")
+	require.NoError(t, err)
+	require.Equal(t, alice.String(), nft.Owner)
+
+	_, err = k.GetListing(ctx, 1)
+	require.ErrorIs(t, err, types.ErrUnknownListing)
+	byOwner, err = k.ListingsByOwner(goCtx, &types.QueryListingsByOwnerRequest{Owner: alice.String()})
+	require.NoError(t, err)
+	require.Empty(t, byOwner.Listings)
+	requireInvariants(t, k, ctx)
+}
+
+func TestBuy(t *testing.T) {
+	k, nk, bk, ctx := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// only the denom creator sets the royalty, up to the max royalty
+	_, err := srv.SetRoyalty(goCtx, types.NewMsgSetRoyalty(alice, "artworks", artist, 500))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.SetRoyalty(goCtx, types.NewMsgSetRoyalty(creator, "artworks", artist, 1001))
+	require.ErrorIs(t, err, types.ErrInvalidRoyalty)
+	_, err = srv.SetRoyalty(goCtx, types.NewMsgSetRoyalty(creator, "artworks", artist, 500))
+	require.NoError(t, err)
+
+	_, err = srv.ListNFT(goCtx, types.NewMsgListNFT(alice, "artworks", "a1", acudos(10_000)))
+	require.NoError(t, err)
+
+	_, err = srv.BuyNFT(goCtx, types.NewMsgBuyNFT(alice, 1))
+	require.ErrorIs(t, err, types.ErrOwnListing)
+	_, err = srv.BuyNFT(goCtx, types.NewMsgBuyNFT(bob, 1))
+	require.Error(t, err)
+
+	bk.Balances[bob.String()] = sdk.NewCoins(acudos(12_000))
+	_, err = srv.BuyNFT(goCtx, types.NewMsgBuyNFT(bob, 1))
+	require.NoError(t, err)
+
+	// 1% fee and 5% royalty
+	require.Equal(t, sdk.NewCoins(acudos(100)), bk.CommunityPool)
+	require.Equal(t, sdk.NewCoins(acudos(500)), bk.Balances[artist.String()])
+	require.Equal(t, sdk.NewCoins(acudos(9_400)), bk.Balances[alice.String()])
+	require.Equal(t, sdk.NewCoins(acudos(2_000)), bk.Balances[bob.String()])
+
+	nft, err := nk.GetNFT(ctx, "artworks", "a1")
+	require.NoError(t, err)
+	require.Equal(t, bob.String(), nft.Owner)
+	_, err = srv.BuyNFT(goCtx, types.NewMsgBuyNFT(bob, 1))
+	require.ErrorIs(t, err, types.ErrUnknownListing)
+	requireInvariants(t, k, ctx)
+
+	// a royalty above a lowered max royalty is capped
+	params := k.GetParams(ctx)
+	params.MaxRoyaltyBps = 200
+	k.SetParams(ctx, params)
+
+	_, err = srv.ListNFT(goCtx, types.NewMsgListNFT(alice, "artworks", "a2", acudos(1_000)))
+	require.NoError(t, err)
+	_, err = srv.BuyNFT(goCtx, types.NewMsgBuyNFT(bob, 2))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(acudos(520)), bk.Balances[artist.String()])
+	require.Equal(t, sdk.NewCoins(acudos(110)), bk.CommunityPool)
+	requireInvariants(t, k, ctx)
+}
+
+func TestBuyNFTCapsRoyaltyBelowFee(t *testing.T) {
+	k, _, bk, ctx := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := srv.SetRoyalty(goCtx, types.NewMsgSetRoyalty(creator, "artworks", artist, 500))
+	require.NoError(t, err)
+
+	// param changes validate each key alone, so the fee and the max royalty
+	// can add up to more than the price
+	params := types.NewParams(6_000, 5_000)
+	require.Error(t, params.Validate())
+	k.SetParams(ctx, params)
+	_, err = srv.SetRoyalty(goCtx, types.NewMsgSetRoyalty(creator, "artworks", artist, 5_000))
+	require.NoError(t, err)
+
+	_, err = srv.ListNFT(goCtx, types.NewMsgListNFT(alice, "artworks", "a1", acudos(10_000)))
+	require.NoError(t, err)
+	bk.Balances[bob.String()] = sdk.NewCoins(acudos(10_000))
+	_, err = srv.BuyNFT(goCtx, types.NewMsgBuyNFT(bob, 1))
+	require.NoError(t, err)
+
+	// the royalty is capped to leave one bps to the seller
+	require.Equal(t, sdk.NewCoins(acudos(6_000)), bk.CommunityPool)
+	require.Equal(t, sdk.NewCoins(acudos(3_999)), bk.Balances[artist.String()])
+	require.Equal(t, sdk.NewCoins(acudos(1)), bk.Balances[alice.String()])
+	require.Empty(t, bk.Balances[bob.String()])
+	requireInvariants(t, k, ctx)
+}
+
+func TestEscrowInvariant(t *testing.T) {
+	k, nk, _, ctx := setupKeeper(t)
+
+	_, err := k.ListNFT(ctx, alice, "artworks", "a1", acudos(1000))
+	require.NoError(t, err)
+	requireInvariants(t, k, ctx)
+
+	// an nft in escrow without a listing breaks the invariant
+	require.NoError(t, nk.TransferOwner(ctx, "artworks", "a2", alice, k.EscrowAddress()))
+	_, broken := keeper.EscrowInvariant(k)(ctx)
+	require.True(t, broken)
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"github.com/CudoVentures/cudos-node/x/marketplace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ListNFT moves an nft of the seller into escrow and lists it at the price.
+func (k Keeper) ListNFT(ctx sdk.Context, seller sdk.AccAddress, denomID, tokenID string, price sdk.Coin) (uint64, error) {
+	if err := k.nftKeeper.TransferOwner(ctx, denomID, tokenID, seller, k.EscrowAddress()); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextListingID(ctx)
+	k.SetNextListingID(ctx, id+1)
+	k.SetListing(ctx, types.Listing{
+		Id:      id,
+		DenomId: denomID,
+		TokenId: tokenID,
+		Seller:  seller.String(),
+		Price:   price,
+	})
+
+	return id, nil
+}
+
+// BuyNFT pays the price of a listing from the buyer, split between the
+// community pool, the royalty recipient of the denom and the seller, and
+// transfers the nft from escrow to the buyer.
+func (k Keeper) BuyNFT(ctx sdk.Context, buyer sdk.AccAddress, listingID uint64) (types.Listing, sdk.Coin, sdk.Coin, error) {
+	listing, err := k.GetListing(ctx, listingID)
+	if err != nil {
+		return types.Listing{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	if listing.Seller == buyer.String() {
+		return types.Listing{}, sdk.Coin{}, sdk.Coin{}, types.ErrOwnListing
+	}
+	seller, err := sdk.AccAddressFromBech32(listing.Seller)
+	if err != nil {
+		return types.Listing{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// royalties set before the max royalty was lowered are capped, and so are
+	// royalties that would leave nothing to the seller after param changes
+	// raised the fee and the max royalty one at a time
+	params := k.GetParams(ctx)
+	var royaltyBps uint32
+	royalty, found := k.GetRoyalty(ctx, listing.DenomId)
+	if found {
+		royaltyBps = royalty.Bps
+		if royaltyBps > params.MaxRoyaltyBps {
+			royaltyBps = params.MaxRoyaltyBps
+		}
+		if maxBps := types.MaxBps - params.FeeBps - 1; royaltyBps > maxBps {
+			royaltyBps = maxBps
+		}
+	}
+
+	fee, royaltyAmount, proceeds := types.SplitPrice(listing.Price, params.FeeBps, royaltyBps)
+
+	if fee.IsPositive() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), buyer); err != nil {
+			return types.Listing{}, sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+	if royaltyAmount.IsPositive() {
+		recipient, err := sdk.AccAddressFromBech32(royalty.Recipient)
+		if err != nil {
+			return types.Listing{}, sdk.Coin{}, sdk.Coin{}, err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, buyer, recipient, sdk.NewCoins(royaltyAmount)); err != nil {
+			return types.Listing{}, sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+	if err := k.bankKeeper.SendCoins(ctx, buyer, seller, sdk.NewCoins(proceeds)); err != nil {
+		return types.Listing{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if err := k.nftKeeper.TransferOwner(ctx, listing.DenomId, listing.TokenId, k.EscrowAddress(), buyer); err != nil {
+		return types.Listing{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	k.deleteListing(ctx, listing)
+
+	return listing, fee, royaltyAmount, nil
+}
+
+// CancelListing returns a listed nft to its seller.
+func (k Keeper) CancelListing(ctx sdk.Context, seller sdk.AccAddress, listingID uint64) (types.Listing, error) {
+	listing, err := k.GetListing(ctx, listingID)
+	if err != nil {
+		return types.Listing{}, err
+	}
+	if listing.Seller != seller.String() {
+		return types.Listing{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the seller of listing %d", seller, listingID)
+	}
+
+	if err := k.nftKeeper.TransferOwner(ctx, listing.DenomId, listing.TokenId, k.EscrowAddress(), seller); err != nil {
+		return types.Listing{}, err
+	}
+	k.deleteListing(ctx, listing)
+
+	return listing, nil
+}
+
+// GetListing returns the listing with the given id.
+func (k Keeper) GetListing(ctx sdk.Context, id uint64) (types.Listing, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ListingKey(id))
+	if bz == nil {
+		return types.Listing{}, sdkerrors.Wrapf(types.ErrUnknownListing, "listing %d", id)
+	}
+
+	var listing types.Listing
+	k.cdc.MustUnmarshal(bz, &listing)
+	return listing, nil
+}
+
+// SetListing stores a listing and its indexes.
+func (k Keeper) SetListing(ctx sdk.Context, listing types.Listing) {
+	seller, err := sdk.AccAddressFromBech32(listing.Seller)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ListingKey(listing.Id), k.cdc.MustMarshal(&listing))
+	store.Set(types.CollectionListingKey(listing.DenomId, listing.Id), []byte{0x01})
+	store.Set(types.OwnerListingKey(seller, listing.Id), []byte{0x01})
+}
+
+func (k Keeper) deleteListing(ctx sdk.Context, listing types.Listing) {
+	seller, err := sdk.AccAddressFromBech32(listing.Seller)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ListingKey(listing.Id))
+	store.Delete(types.CollectionListingKey(listing.DenomId, listing.Id))
+	store.Delete(types.OwnerListingKey(seller, listing.Id))
+}
+
+// IterateListings iterates over the listings in id order until cb returns
+// true.
+func (k Keeper) IterateListings(ctx sdk.Context, cb func(listing types.Listing) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ListingKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var listing types.Listing
+		k.cdc.MustUnmarshal(iterator.Value(), &listing)
+		if cb(listing) {
+			break
+		}
+	}
+}
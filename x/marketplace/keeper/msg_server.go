@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/CudoVentures/cudos-node/x/marketplace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (m msgServer) ListNFT(goCtx context.Context, msg *types.MsgListNFT) (*types.MsgListNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := m.Keeper.ListNFT(ctx, seller, msg.DenomId, msg.TokenId, msg.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeListNFT,
+			sdk.NewAttribute(types.AttributeKeyListingID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenomID, msg.DenomId),
+			sdk.NewAttribute(types.AttributeKeyTokenID, msg.TokenId),
+			sdk.NewAttribute(types.AttributeKeySeller, msg.Seller),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Seller),
+		),
+	})
+
+	return &types.MsgListNFTResponse{ListingId: id}, nil
+}
+
+func (m msgServer) BuyNFT(goCtx context.Context, msg *types.MsgBuyNFT) (*types.MsgBuyNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	listing, fee, royalty, err := m.Keeper.BuyNFT(ctx, buyer, msg.ListingId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBuyNFT,
+			sdk.NewAttribute(types.AttributeKeyListingID, strconv.FormatUint(listing.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenomID, listing.DenomId),
+			sdk.NewAttribute(types.AttributeKeyTokenID, listing.TokenId),
+			sdk.NewAttribute(types.AttributeKeySeller, listing.Seller),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer),
+			sdk.NewAttribute(types.AttributeKeyPrice, listing.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyRoyalty, royalty.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Buyer),
+		),
+	})
+
+	return &types.MsgBuyNFTResponse{}, nil
+}
+
+func (m msgServer) CancelListing(goCtx context.Context, msg *types.MsgCancelListing) (*types.MsgCancelListingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	listing, err := m.Keeper.CancelListing(ctx, seller, msg.ListingId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelListing,
+			sdk.NewAttribute(types.AttributeKeyListingID, strconv.FormatUint(listing.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenomID, listing.DenomId),
+			sdk.NewAttribute(types.AttributeKeyTokenID, listing.TokenId),
+			sdk.NewAttribute(types.AttributeKeySeller, listing.Seller),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Seller),
+		),
+	})
+
+	return &types.MsgCancelListingResponse{}, nil
+}
+
+func (m msgServer) SetRoyalty(goCtx context.Context, msg *types.MsgSetRoyalty) (*types.MsgSetRoyaltyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom, err := m.Keeper.nftKeeper.GetDenom(ctx, msg.DenomId)
+	if err != nil {
+		return nil, err
+	}
+	if denom.Creator != msg.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the creator of denom %s", msg.Creator, msg.DenomId)
+	}
+
+	if err := m.Keeper.SetRoyalty(ctx, types.Royalty{DenomId: msg.DenomId, Recipient: msg.Recipient, Bps: msg.Bps}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetRoyalty,
+			sdk.NewAttribute(types.AttributeKeyDenomID, msg.DenomId),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyBps, strconv.FormatUint(uint64(msg.Bps), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator),
+		),
+	})
+
+	return &types.MsgSetRoyaltyResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgListNFT{}, "marketplace/ListNFT", nil)
+	cdc.RegisterConcrete(&MsgBuyNFT{}, "marketplace/BuyNFT", nil)
+	cdc.RegisterConcrete(&MsgCancelListing{}, "marketplace/CancelListing", nil)
+	cdc.RegisterConcrete(&MsgSetRoyalty{}, "marketplace/SetRoyalty", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgListNFT{},
+		&MsgBuyNFT{},
+		&MsgCancelListing{},
+		&MsgSetRoyalty{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
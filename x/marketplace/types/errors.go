@@ -0,0 +1,19 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/marketplace module sentinel errors
+var (
+	ErrUnknownListing = sdkerrors.Register(ModuleName, 1100, "unknown listing")
+	ErrInvalidPrice   = sdkerrors.Register(ModuleName, 1101, "invalid price")
+	ErrInvalidRoyalty = sdkerrors.Register(ModuleName, 1102, "invalid royalty")
+	ErrUnauthorized   = sdkerrors.Register(ModuleName, 1103, "unauthorized address")
+	ErrOwnListing     = sdkerrors.Register(ModuleName, 1104, "the seller cannot buy its own listing")
+	ErrInvalidListing = sdkerrors.Register(ModuleName, 1105, "invalid listing")
+	ErrUnknownRoyalty = sdkerrors.Register(ModuleName, 1106, "no royalty set")
+	ErrInvalidGenesis = sdkerrors.Register(ModuleName, 1107, "invalid genesis")
+)
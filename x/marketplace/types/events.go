@@ -0,0 +1,20 @@
+package types
+
+// marketplace module event types
+const (
+	EventTypeListNFT       = "list_nft"
+	EventTypeBuyNFT        = "buy_nft"
+	EventTypeCancelListing = "cancel_listing"
+	EventTypeSetRoyalty    = "set_royalty"
+
+	AttributeKeyListingID = "listing_id"
+	AttributeKeyDenomID   = "denom_id"
+	AttributeKeyTokenID   = "token_id"
+	AttributeKeySeller    = "seller"
+	AttributeKeyBuyer     = "buyer"
+	AttributeKeyPrice     = "price"
+	AttributeKeyFee       = "fee"
+	AttributeKeyRoyalty   = "royalty"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyBps       = "bps"
+)
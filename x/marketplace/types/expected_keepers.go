@@ -0,0 +1,33 @@
+package types
+
+import (
+	nfttypes "github.com/CudoVentures/cudos-node/x/nft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the contract required for account APIs.
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the contract needed to pay the sellers.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the contract needed to pay the marketplace fee
+// to the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// NftKeeper defines the contract needed to hold the listed nfts in escrow.
+type NftKeeper interface {
+	GetDenom(ctx sdk.Context, denomID string) (nfttypes.Denom, error)
+	GetNFT(ctx sdk.Context, denomID, tokenID string) (nfttypes.BaseNFT, error)
+	TransferOwner(ctx sdk.Context, denomID, tokenID string, owner, recipient sdk.AccAddress) error
+	IterateDenoms(ctx sdk.Context, cb func(denom nfttypes.Denom) (stop bool))
+	GetOwnerSupply(ctx sdk.Context, denomID string, owner sdk.AccAddress) uint64
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, listings []Listing, royalties []Royalty, nextListingID uint64) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		Listings:      listings,
+		Royalties:     royalties,
+		NextListingId: nextListingID,
+	}
+}
+
+// DefaultGenesis returns the default marketplace genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Listing{}, []Royalty{}, 1)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[uint64]bool, len(gs.Listings))
+	tokens := make(map[string]bool, len(gs.Listings))
+	for _, listing := range gs.Listings {
+		if err := listing.Validate(); err != nil {
+			return err
+		}
+		if listing.Id == 0 || listing.Id >= gs.NextListingId {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "listing id %d must be between 1 and the next listing id %d", listing.Id, gs.NextListingId)
+		}
+		if ids[listing.Id] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate listing %d", listing.Id)
+		}
+		ids[listing.Id] = true
+
+		token := listing.DenomId + "/" + listing.TokenId
+		if tokens[token] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "nft %s is listed twice", token)
+		}
+		tokens[token] = true
+	}
+
+	denoms := make(map[string]bool, len(gs.Royalties))
+	for _, royalty := range gs.Royalties {
+		if err := royalty.Validate(); err != nil {
+			return err
+		}
+		if royalty.Bps > gs.Params.MaxRoyaltyBps {
+			return sdkerrors.Wrapf(ErrInvalidRoyalty, "royalty of %s exceeds %d bps", royalty.DenomId, gs.Params.MaxRoyaltyBps)
+		}
+		if denoms[royalty.DenomId] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate royalty of %s", royalty.DenomId)
+		}
+		denoms[royalty.DenomId] = true
+	}
+
+	if gs.NextListingId == 0 {
+		return sdkerrors.Wrap(ErrInvalidGenesis, "the next listing id must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "marketplace"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for marketplace
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ListingKeyPrefix prefixes the listings, stored under
+	// ListingKeyPrefix | big endian id.
+	ListingKeyPrefix = []byte{0x01}
+
+	// CollectionListingKeyPrefix prefixes the listing index by denom, an
+	// empty value stored under
+	// CollectionListingKeyPrefix | len(denom id) | denom id | big endian id.
+	CollectionListingKeyPrefix = []byte{0x02}
+
+	// OwnerListingKeyPrefix prefixes the listing index by seller, an empty
+	// value stored under OwnerListingKeyPrefix | len(seller) | seller | big endian id.
+	OwnerListingKeyPrefix = []byte{0x03}
+
+	// RoyaltyKeyPrefix prefixes the royalties, stored under
+	// RoyaltyKeyPrefix | denom id.
+	RoyaltyKeyPrefix = []byte{0x04}
+
+	// NextListingIDKey stores the id of the next listing.
+	NextListingIDKey = []byte{0x05}
+)
+
+// ListingKey returns the key of the listing with the given id.
+func ListingKey(id uint64) []byte {
+	return append(append([]byte{}, ListingKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// CollectionListingsKey returns the prefix of the listings of the given denom.
+func CollectionListingsKey(denomID string) []byte {
+	key := append([]byte{}, CollectionListingKeyPrefix...)
+	key = append(key, byte(len(denomID)))
+	return append(key, []byte(denomID)...)
+}
+
+// CollectionListingKey returns the index key of a listing of the given denom.
+func CollectionListingKey(denomID string, id uint64) []byte {
+	return append(CollectionListingsKey(denomID), sdk.Uint64ToBigEndian(id)...)
+}
+
+// OwnerListingsKey returns the prefix of the listings of the given seller.
+func OwnerListingsKey(seller sdk.AccAddress) []byte {
+	key := append([]byte{}, OwnerListingKeyPrefix...)
+	key = append(key, byte(len(seller)))
+	return append(key, seller...)
+}
+
+// OwnerListingKey returns the index key of a listing of the given seller.
+func OwnerListingKey(seller sdk.AccAddress, id uint64) []byte {
+	return append(OwnerListingsKey(seller), sdk.Uint64ToBigEndian(id)...)
+}
+
+// RoyaltyKey returns the key of the royalty of the given denom.
+func RoyaltyKey(denomID string) []byte {
+	return append(append([]byte{}, RoyaltyKeyPrefix...), []byte(denomID)...)
+}
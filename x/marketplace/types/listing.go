@@ -0,0 +1,61 @@
+package types
+
+import (
+	nfttypes "github.com/CudoVentures/cudos-node/x/nft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidatePrice checks that a price is a positive amount of a valid denom.
+func ValidatePrice(price sdk.Coin) error {
+	if err := price.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPrice, err.Error())
+	}
+	if !price.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidPrice, "price must be positive")
+	}
+	return nil
+}
+
+// Validate checks the fields of a listing.
+func (l Listing) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Seller); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller of listing %d (%s)", l.Id, err)
+	}
+	if err := nfttypes.ValidateDenomID(l.DenomId); err != nil {
+		return err
+	}
+	if err := nfttypes.ValidateTokenID(l.TokenId); err != nil {
+		return err
+	}
+	return ValidatePrice(l.Price)
+}
+
+// Validate checks the fields of a royalty, but not against the max royalty
+// param.
+func (r Royalty) Validate() error {
+	if err := nfttypes.ValidateDenomID(r.DenomId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid royalty recipient (%s)", err)
+	}
+	if r.Bps >= MaxBps {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "royalty must be below %d bps: %d", MaxBps, r.Bps)
+	}
+	return nil
+}
+
+// SplitPrice splits the price of a sale into the marketplace fee, the royalty
+// and the share of the seller. The fee and the royalty are rounded down, and
+// together must be below MaxBps.
+func SplitPrice(price sdk.Coin, feeBps, royaltyBps uint32) (fee, royalty, proceeds sdk.Coin) {
+	share := func(bps uint32) sdk.Coin {
+		return sdk.NewCoin(price.Denom, price.Amount.MulRaw(int64(bps)).QuoRaw(MaxBps))
+	}
+
+	fee = share(feeBps)
+	royalty = share(royaltyBps)
+	proceeds = price.Sub(fee).Sub(royalty)
+	return fee, royalty, proceeds
+}
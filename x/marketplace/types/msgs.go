@@ -0,0 +1,147 @@
+package types
+
+import (
+	nfttypes "github.com/CudoVentures/cudos-node/x/nft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgListNFT       = "list_nft"
+	TypeMsgBuyNFT        = "buy_nft"
+	TypeMsgCancelListing = "cancel_listing"
+	TypeMsgSetRoyalty    = "set_royalty"
+)
+
+var (
+	_ sdk.Msg = &MsgListNFT{}
+	_ sdk.Msg = &MsgBuyNFT{}
+	_ sdk.Msg = &MsgCancelListing{}
+	_ sdk.Msg = &MsgSetRoyalty{}
+)
+
+func NewMsgListNFT(seller sdk.AccAddress, denomID, tokenID string, price sdk.Coin) *MsgListNFT {
+	return &MsgListNFT{Seller: seller.String(), DenomId: denomID, TokenId: tokenID, Price: price}
+}
+
+// Route Implements Msg.
+func (msg MsgListNFT) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgListNFT) Type() string { return TypeMsgListNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgListNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid seller address (%s)", err)
+	}
+	if err := nfttypes.ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := nfttypes.ValidateTokenID(msg.TokenId); err != nil {
+		return err
+	}
+	return ValidatePrice(msg.Price)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgListNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgListNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Seller)}
+}
+
+func NewMsgBuyNFT(buyer sdk.AccAddress, listingID uint64) *MsgBuyNFT {
+	return &MsgBuyNFT{Buyer: buyer.String(), ListingId: listingID}
+}
+
+// Route Implements Msg.
+func (msg MsgBuyNFT) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBuyNFT) Type() string { return TypeMsgBuyNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBuyNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid buyer address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBuyNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBuyNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Buyer)}
+}
+
+func NewMsgCancelListing(seller sdk.AccAddress, listingID uint64) *MsgCancelListing {
+	return &MsgCancelListing{Seller: seller.String(), ListingId: listingID}
+}
+
+// Route Implements Msg.
+func (msg MsgCancelListing) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCancelListing) Type() string { return TypeMsgCancelListing }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelListing) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid seller address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelListing) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelListing) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Seller)}
+}
+
+func NewMsgSetRoyalty(creator sdk.AccAddress, denomID string, recipient sdk.AccAddress, bps uint32) *MsgSetRoyalty {
+	return &MsgSetRoyalty{Creator: creator.String(), DenomId: denomID, Recipient: recipient.String(), Bps: bps}
+}
+
+// Route Implements Msg.
+func (msg MsgSetRoyalty) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetRoyalty) Type() string { return TypeMsgSetRoyalty }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetRoyalty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", err)
+	}
+	return Royalty{DenomId: msg.DenomId, Recipient: msg.Recipient, Bps: msg.Bps}.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetRoyalty) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetRoyalty) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Creator)}
+}
+
+func mustAccAddress(addr string) sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		panic(err)
+	}
+	return accAddr
+}
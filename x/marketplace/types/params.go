@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyFeeBps        = []byte("FeeBps")
+	KeyMaxRoyaltyBps = []byte("MaxRoyaltyBps")
+)
+
+// MaxBps is 100% in basis points.
+const MaxBps = 10000
+
+// ParamKeyTable ParamTable for marketplace module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(feeBps, maxRoyaltyBps uint32) Params {
+	return Params{
+		FeeBps:        feeBps,
+		MaxRoyaltyBps: maxRoyaltyBps,
+	}
+}
+
+// DefaultParams default marketplace module parameters
+func DefaultParams() Params {
+	return Params{
+		FeeBps:        100,  // 1%
+		MaxRoyaltyBps: 1000, // 10%
+	}
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	if err := validateBps(p.FeeBps); err != nil {
+		return err
+	}
+	if err := validateBps(p.MaxRoyaltyBps); err != nil {
+		return err
+	}
+
+	// the seller must always get a share of the price
+	if p.FeeBps+p.MaxRoyaltyBps >= MaxBps {
+		return fmt.Errorf("fee and max royalty must be below %d bps: %d", MaxBps, p.FeeBps+p.MaxRoyaltyBps)
+	}
+	return nil
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeBps, &p.FeeBps, validateBps),
+		paramtypes.NewParamSetPair(KeyMaxRoyaltyBps, &p.MaxRoyaltyBps, validateBps),
+	}
+}
+
+func validateBps(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v >= MaxBps {
+		return fmt.Errorf("basis points must be below %d: %d", MaxBps, v)
+	}
+	return nil
+}
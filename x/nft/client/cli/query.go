@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/CudoVentures/cudos-node/x/nft/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	// Group nft queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryDenom(),
+		CmdQueryDenoms(),
+		CmdQueryCollection(),
+		CmdQueryNFT(),
+		CmdQuerySupply(),
+		CmdQueryOwner(),
+	)
+
+	return cmd
+}
+
+func CmdQueryDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom [denom-id]",
+		Short: "Query a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Denom(context.Background(), &types.QueryDenomRequest{DenomId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms",
+		Short: "Query all denoms",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Denoms(context.Background(), &types.QueryDenomsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denoms")
+
+	return cmd
+}
+
+func CmdQueryCollection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collection [denom-id]",
+		Short: "Query a denom and its tokens",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Collection(context.Background(), &types.QueryCollectionRequest{DenomId: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "collection")
+
+	return cmd
+}
+
+func CmdQueryNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token [denom-id] [token-id]",
+		Short: "Query a token",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NFT(context.Background(), &types.QueryNFTRequest{DenomId: args[0], TokenId: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply [denom-id]",
+		Short: "Query the number of tokens of a denom, or of the tokens of --owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, _ := cmd.Flags().GetString(FlagOwner)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Supply(context.Background(), &types.QuerySupplyRequest{DenomId: args[0], Owner: owner})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagOwner, "", "Count the tokens of this owner only")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner [address]",
+		Short: "Query the tokens of an owner, of all denoms or of --denom-id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denomID, _ := cmd.Flags().GetString(FlagDenomID)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Owner(context.Background(), &types.QueryOwnerRequest{DenomId: denomID, Owner: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenomID, "", "Only query the tokens of this denom")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owner")
+
+	return cmd
+}
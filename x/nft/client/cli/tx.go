@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/CudoVentures/cudos-node/x/nft/types"
+)
+
+const (
+	FlagName        = "name"
+	FlagSchema      = "schema"
+	FlagSymbol      = "symbol"
+	FlagDescription = "description"
+	FlagData        = "data"
+	FlagURI         = "uri"
+	FlagRecipient   = "recipient"
+	FlagOwner       = "owner"
+	FlagDenomID     = "denom-id"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdIssueDenom(),
+		CmdMintNFT(),
+		CmdEditNFT(),
+		CmdTransferNFT(),
+		CmdBurnNFT(),
+	)
+
+	return cmd
+}
+
+func CmdIssueDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue [denom-id]",
+		Short: "Issue a new denom, whose tokens only the sender can mint",
+		Example: fmt.Sprintf(
+			"$ %s tx nft issue artworks --name \"Artworks\" --symbol ART --from mykey",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, _ := cmd.Flags().GetString(FlagName)
+			schema, _ := cmd.Flags().GetString(FlagSchema)
+			symbol, _ := cmd.Flags().GetString(FlagSymbol)
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			data, _ := cmd.Flags().GetString(FlagData)
+
+			msg := types.NewMsgIssueDenom(args[0], name, schema, clientCtx.GetFromAddress().String(), symbol, description, data)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "Name of the denom")
+	cmd.Flags().String(FlagSchema, "", "JSON schema of the token data")
+	cmd.Flags().String(FlagSymbol, "", "Symbol of the denom")
+	cmd.Flags().String(FlagDescription, "", "Description of the denom")
+	cmd.Flags().String(FlagData, "", "Metadata of the denom")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdMintNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [denom-id] [token-id]",
+		Short: "Mint a token of a denom created by the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			recipient, _ := cmd.Flags().GetString(FlagRecipient)
+			if recipient == "" {
+				recipient = sender
+			}
+			name, _ := cmd.Flags().GetString(FlagName)
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			data, _ := cmd.Flags().GetString(FlagData)
+
+			msg := types.NewMsgMintNFT(args[1], args[0], name, uri, data, sender, recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRecipient, "", "Owner of the token, defaults to the sender")
+	cmd.Flags().String(FlagName, "", "Name of the token")
+	cmd.Flags().String(FlagURI, "", "URI of the token metadata")
+	cmd.Flags().String(FlagData, "", "Data of the token")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEditNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit [denom-id] [token-id]",
+		Short: "Edit the name, uri or data of a token of the sender, the fields without a flag are not changed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, _ := cmd.Flags().GetString(FlagName)
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			data, _ := cmd.Flags().GetString(FlagData)
+
+			msg := types.NewMsgEditNFT(args[1], args[0], name, uri, data, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, types.DoNotModify, "Name of the token")
+	cmd.Flags().String(FlagURI, types.DoNotModify, "URI of the token metadata")
+	cmd.Flags().String(FlagData, types.DoNotModify, "Data of the token")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTransferNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [recipient] [denom-id] [token-id]",
+		Short: "Transfer a token of the sender",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferNFT(args[2], args[1], clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBurnNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [denom-id] [token-id]",
+		Short: "Burn a token of the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnNFT(args[1], args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
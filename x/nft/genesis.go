@@ -0,0 +1,38 @@
+package nft
+
+import (
+	"github.com/CudoVentures/cudos-node/x/nft/keeper"
+	"github.com/CudoVentures/cudos-node/x/nft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the nft module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for _, collection := range data.Collections {
+		if err := k.IssueDenom(ctx, collection.Denom); err != nil {
+			panic(err)
+		}
+
+		for _, nft := range collection.Nfts {
+			if err := k.MintNFT(ctx, collection.Denom.Id, nft); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// ExportGenesis returns the nft module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	collections := []types.Collection{}
+	k.IterateDenoms(ctx, func(denom types.Denom) bool {
+		collection, err := k.GetCollection(ctx, denom.Id)
+		if err != nil {
+			panic(err)
+		}
+		collections = append(collections, collection)
+		return false
+	})
+
+	return types.NewGenesisState(collections)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/CudoVentures/cudos-node/x/nft/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Supply(goCtx context.Context, req *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Owner == "" {
+		return &types.QuerySupplyResponse{Amount: k.GetSupply(ctx, req.DenomId)}, nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", req.Owner)
+	}
+	return &types.QuerySupplyResponse{Amount: k.GetOwnerSupply(ctx, req.DenomId, owner)}, nil
+}
+
+func (k Keeper) Owner(goCtx context.Context, req *types.QueryOwnerRequest) (*types.QueryOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", req.Owner)
+	}
+
+	keyPrefix := types.OwnerKey(owner)
+	if req.DenomId != "" {
+		keyPrefix = types.OwnerDenomKey(owner, req.DenomId)
+	}
+
+	// the tokens are grouped by denom in key order
+	res := types.Owner{Address: req.Owner, IdCollections: []types.IDCollection{}}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		denomID, tokenID := req.DenomId, string(key)
+		if req.DenomId == "" {
+			denomID, tokenID = types.SplitOwnerNFTKey(key)
+		}
+
+		if last := len(res.IdCollections) - 1; last >= 0 && res.IdCollections[last].DenomId == denomID {
+			res.IdCollections[last].TokenIds = append(res.IdCollections[last].TokenIds, tokenID)
+		} else {
+			res.IdCollections = append(res.IdCollections, types.IDCollection{DenomId: denomID, TokenIds: []string{tokenID}})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOwnerResponse{Owner: res, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Collection(goCtx context.Context, req *types.QueryCollectionRequest) (*types.QueryCollectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom, err := k.GetDenom(ctx, req.DenomId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	nfts := []types.BaseNFT{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CollectionKey(req.DenomId))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var nft types.BaseNFT
+		if err := k.cdc.Unmarshal(value, &nft); err != nil {
+			return err
+		}
+		nfts = append(nfts, nft)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCollectionResponse{
+		Collection: types.Collection{Denom: denom, Nfts: nfts},
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) Denom(goCtx context.Context, req *types.QueryDenomRequest) (*types.QueryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom, err := k.GetDenom(ctx, req.DenomId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDenomResponse{Denom: denom}, nil
+}
+
+func (k Keeper) Denoms(goCtx context.Context, req *types.QueryDenomsRequest) (*types.QueryDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denoms := []types.Denom{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var denom types.Denom
+		if err := k.cdc.Unmarshal(value, &denom); err != nil {
+			return err
+		}
+		denoms = append(denoms, denom)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+func (k Keeper) NFT(goCtx context.Context, req *types.QueryNFTRequest) (*types.QueryNFTResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	nft, err := k.GetNFT(ctx, req.DenomId, req.TokenId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryNFTResponse{Nft: nft}, nil
+}
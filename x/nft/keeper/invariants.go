@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/CudoVentures/cudos-node/x/nft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the nft module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply", SupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "owners", OwnersInvariant(k))
+}
+
+// SupplyInvariant checks that the supply of every denom is its number of
+// tokens.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		k.IterateDenoms(ctx, func(denom types.Denom) bool {
+			var count uint64
+			k.IterateNFTs(ctx, denom.Id, func(types.BaseNFT) bool {
+				count++
+				return false
+			})
+
+			if supply := k.GetSupply(ctx, denom.Id); supply != count {
+				broken = true
+				msg += fmt.Sprintf("\tdenom %s has a supply of %d but %d nfts\n", denom.Id, supply, count)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "supply", msg), broken
+	}
+}
+
+// OwnersInvariant checks that the owner index holds exactly the owner of
+// every token.
+func OwnersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		store := ctx.KVStore(k.storeKey)
+
+		var tokens int
+		k.IterateDenoms(ctx, func(denom types.Denom) bool {
+			k.IterateNFTs(ctx, denom.Id, func(nft types.BaseNFT) bool {
+				tokens++
+				owner, err := sdk.AccAddressFromBech32(nft.Owner)
+				if err != nil || !store.Has(types.OwnerNFTKey(owner, denom.Id, nft.Id)) {
+					broken = true
+					msg += fmt.Sprintf("\tnft %s/%s is missing from the index of owner %s\n", denom.Id, nft.Id, nft.Owner)
+				}
+				return false
+			})
+			return false
+		})
+
+		var indexed int
+		iterator := sdk.KVStorePrefixIterator(store, types.OwnerKeyPrefix)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			indexed++
+		}
+		if indexed != tokens {
+			broken = true
+			msg += fmt.Sprintf("\tthe owner index has %d entries for %d nfts\n", indexed, tokens)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "owners", msg), broken
+	}
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/CudoVentures/cudos-node/x/nft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type (
+	Keeper struct {
+		cdc      codec.Codec
+		storeKey sdk.StoreKey
+	}
+)
+
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey sdk.StoreKey,
+) *Keeper {
+	return &Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// IssueDenom creates a new denom.
+func (k Keeper) IssueDenom(ctx sdk.Context, denom types.Denom) error {
+	if k.HasDenom(ctx, denom.Id) {
+		return sdkerrors.Wrapf(types.ErrDenomExists, "denom %s", denom.Id)
+	}
+
+	k.setDenom(ctx, denom)
+	k.setSupply(ctx, denom.Id, 0)
+	return nil
+}
+
+// HasDenom returns whether the denom exists.
+func (k Keeper) HasDenom(ctx sdk.Context, denomID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DenomKey(denomID))
+}
+
+// GetDenom returns the denom with the given id.
+func (k Keeper) GetDenom(ctx sdk.Context, denomID string) (types.Denom, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DenomKey(denomID))
+	if bz == nil {
+		return types.Denom{}, sdkerrors.Wrapf(types.ErrUnknownDenom, "denom %s", denomID)
+	}
+
+	var denom types.Denom
+	k.cdc.MustUnmarshal(bz, &denom)
+	return denom, nil
+}
+
+func (k Keeper) setDenom(ctx sdk.Context, denom types.Denom) {
+	ctx.KVStore(k.storeKey).Set(types.DenomKey(denom.Id), k.cdc.MustMarshal(&denom))
+}
+
+// IterateDenoms iterates over the denoms in id order until cb returns true.
+func (k Keeper) IterateDenoms(ctx sdk.Context, cb func(denom types.Denom) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DenomKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var denom types.Denom
+		k.cdc.MustUnmarshal(iterator.Value(), &denom)
+		if cb(denom) {
+			break
+		}
+	}
+}
+
+// GetSupply returns the number of tokens of the denom.
+func (k Keeper) GetSupply(ctx sdk.Context, denomID string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.SupplyKey(denomID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setSupply(ctx sdk.Context, denomID string, supply uint64) {
+	ctx.KVStore(k.storeKey).Set(types.SupplyKey(denomID), sdk.Uint64ToBigEndian(supply))
+}
+
+// GetOwnerSupply returns the number of tokens of the denom held by the owner.
+func (k Keeper) GetOwnerSupply(ctx sdk.Context, denomID string, owner sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OwnerDenomKey(owner, denomID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var supply uint64
+	for ; iterator.Valid(); iterator.Next() {
+		supply++
+	}
+	return supply
+}
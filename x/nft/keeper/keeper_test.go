@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/nft"
+	"github.com/CudoVentures/cudos-node/x/nft/keeper"
+	"github.com/CudoVentures/cudos-node/x/nft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var (
+	creator = sdk.AccAddress([]byte("creator_____________"))
+	alice   = sdk.AccAddress([]byte("alice_______________"))
+	bob     = sdk.AccAddress([]byte("bob_________________"))
+)
+
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *sdk.KVStoreKey) {
+	stores := keepertest.NewStores()
+	storeKey := stores.KVStoreKey(types.StoreKey)
+	k := keeper.NewKeeper(stores.Codec, storeKey)
+	return *k, stores.Context(t, tmproto.Header{}), storeKey
+}
+
+func requireInvariants(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+	keepertest.RequireInvariants(t, ctx, keeper.SupplyInvariant(k), keeper.OwnersInvariant(k))
+}
+
+func TestNFTLifecycle(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := srv.IssueDenom(goCtx, types.NewMsgIssueDenom("artworks", "Artworks", "", creator.String(), "ART", "", ""))
+	require.NoError(t, err)
+	_, err = srv.IssueDenom(goCtx, types.NewMsgIssueDenom("artworks", "Other", "", alice.String(), "", "", ""))
+	require.ErrorIs(t, err, types.ErrDenomExists)
+
+	// only the creator mints
+	_, err = srv.MintNFT(goCtx, types.NewMsgMintNFT("a1", "artworks", "first", "ipfs://a1", "", alice.String(), alice.String()))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.MintNFT(goCtx, types.NewMsgMintNFT("a1", "artworks", "first", "ipfs://a1", "", creator.String(), alice.String()))
+	require.NoError(t, err)
+	_, err = srv.MintNFT(goCtx, types.NewMsgMintNFT("a1", "artworks", "again", "", "", creator.String(), alice.String()))
+	require.ErrorIs(t, err, types.ErrNFTExists)
+	_, err = srv.MintNFT(goCtx, types.NewMsgMintNFT("a2", "artworks", "second", "", "", creator.String(), alice.String()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), k.GetSupply(ctx, "artworks"))
+
+	// only the owner edits, transfers and burns
+	_, err = srv.EditNFT(goCtx, types.NewMsgEditNFT("a1", "artworks", types.DoNotModify, "ipfs://new", types.DoNotModify, bob.String()))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.EditNFT(goCtx, types.NewMsgEditNFT("a1", "artworks", types.DoNotModify, "ipfs://new", types.DoNotModify, alice.String()))
+	require.NoError(t, err)
+	token, err := k.GetNFT(ctx, "artworks", "a1")
+	require.NoError(t, err)
+	require.Equal(t, "first", token.Name)
+	require.Equal(t, "ipfs://new", token.Uri)
+
+	_, err = srv.TransferNFT(goCtx, types.NewMsgTransferNFT("a1", "artworks", bob.String(), alice.String()))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.TransferNFT(goCtx, types.NewMsgTransferNFT("a1", "artworks", alice.String(), bob.String()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), k.GetOwnerSupply(ctx, "artworks", alice))
+	require.Equal(t, uint64(1), k.GetOwnerSupply(ctx, "artworks", bob))
+
+	_, err = srv.BurnNFT(goCtx, types.NewMsgBurnNFT("a2", "artworks", bob.String()))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.BurnNFT(goCtx, types.NewMsgBurnNFT("a2", "artworks", alice.String()))
+	require.NoError(t, err)
+	require.False(t, k.HasNFT(ctx, "artworks", "a2"))
+	require.Equal(t, uint64(1), k.GetSupply(ctx, "artworks"))
+	require.Zero(t, k.GetOwnerSupply(ctx, "artworks", alice))
+
+	requireInvariants(t, k, ctx)
+}
+
+func TestOwnerQuery(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	for _, denomID := range []string{"artworks", "tickets"} {
+		require.NoError(t, k.IssueDenom(ctx, types.Denom{Id: denomID, Name: denomID, Creator: creator.String()}))
+		for _, tokenID := range []string{"t1", "t2"} {
+			require.NoError(t, k.MintNFT(ctx, denomID, types.BaseNFT{Id: tokenID, Owner: alice.String()}))
+		}
+	}
+	require.NoError(t, k.MintNFT(ctx, "tickets", types.BaseNFT{Id: "t3", Owner: bob.String()}))
+
+	res, err := k.Owner(goCtx, &types.QueryOwnerRequest{Owner: alice.String()})
+	require.NoError(t, err)
+	// the denoms are in key order, which puts shorter ids first
+	require.Equal(t, []types.IDCollection{
+		{DenomId: "tickets", TokenIds: []string{"t1", "t2"}},
+		{DenomId: "artworks", TokenIds: []string{"t1", "t2"}},
+	}, res.Owner.IdCollections)
+
+	res, err = k.Owner(goCtx, &types.QueryOwnerRequest{Owner: alice.String(), DenomId: "tickets", Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.IDCollection{{DenomId: "tickets", TokenIds: []string{"t1"}}}, res.Owner.IdCollections)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	supply, err := k.Supply(goCtx, &types.QuerySupplyRequest{DenomId: "tickets"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), supply.Amount)
+	supply, err = k.Supply(goCtx, &types.QuerySupplyRequest{DenomId: "tickets", Owner: bob.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), supply.Amount)
+}
+
+func TestGenesisAndMigration(t *testing.T) {
+	k, ctx, storeKey := setupKeeper(t)
+
+	genState := types.NewGenesisState([]types.Collection{{
+		Denom: types.Denom{Id: "artworks", Name: "Artworks", Creator: creator.String()},
+		Nfts: []types.BaseNFT{
+			{Id: "a1", Uri: "ipfs://a1", Owner: alice.String()},
+			{Id: "a2", Owner: bob.String()},
+		},
+	}})
+	require.NoError(t, genState.Validate())
+	nft.InitGenesis(ctx, k, *genState)
+	require.Equal(t, genState, nft.ExportGenesis(ctx, k))
+	requireInvariants(t, k, ctx)
+
+	// version 1 stored no supply
+	ctx.KVStore(storeKey).Delete(types.SupplyKey("artworks"))
+	_, broken := keeper.SupplyInvariant(k)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, uint64(2), k.GetSupply(ctx, "artworks"))
+	requireInvariants(t, k, ctx)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	denom := types.Denom{Id: "artworks", Name: "Artworks", Creator: creator.String()}
+	token := types.BaseNFT{Id: "a1", Owner: alice.String()}
+
+	require.NoError(t, types.DefaultGenesis().Validate())
+	require.Error(t, types.NewGenesisState([]types.Collection{{Denom: denom}, {Denom: denom}}).Validate())
+	require.Error(t, types.NewGenesisState([]types.Collection{{Denom: denom, Nfts: []types.BaseNFT{token, token}}}).Validate())
+	require.Error(t, types.NewGenesisState([]types.Collection{{Denom: types.Denom{Id: "Art", Name: "Art", Creator: creator.String()}}}).Validate())
+	require.Error(t, types.NewGenesisState([]types.Collection{{Denom: denom, Nfts: []types.BaseNFT{{Id: "a1"}}}}).Validate())
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"github.com/CudoVentures/cudos-node/x/nft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2 by counting the tokens of every
+// denom into the supply, which version 1 did not store.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.IterateDenoms(ctx, func(denom types.Denom) bool {
+		var supply uint64
+		m.keeper.IterateNFTs(ctx, denom.Id, func(types.BaseNFT) bool {
+			supply++
+			return false
+		})
+		m.keeper.setSupply(ctx, denom.Id, supply)
+		return false
+	})
+	return nil
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"github.com/CudoVentures/cudos-node/x/nft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MintNFT mints a token of an existing denom to the owner.
+func (k Keeper) MintNFT(ctx sdk.Context, denomID string, nft types.BaseNFT) error {
+	if !k.HasDenom(ctx, denomID) {
+		return sdkerrors.Wrapf(types.ErrUnknownDenom, "denom %s", denomID)
+	}
+	if k.HasNFT(ctx, denomID, nft.Id) {
+		return sdkerrors.Wrapf(types.ErrNFTExists, "nft %s/%s", denomID, nft.Id)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(nft.Owner)
+	if err != nil {
+		return err
+	}
+
+	k.setNFT(ctx, denomID, nft)
+	k.setOwner(ctx, owner, denomID, nft.Id)
+	k.setSupply(ctx, denomID, k.GetSupply(ctx, denomID)+1)
+	return nil
+}
+
+// EditNFT changes the fields of the token that are not types.DoNotModify,
+// the owner must hold the token.
+func (k Keeper) EditNFT(ctx sdk.Context, denomID, tokenID, name, uri, data string, owner sdk.AccAddress) error {
+	nft, err := k.authorize(ctx, denomID, tokenID, owner)
+	if err != nil {
+		return err
+	}
+
+	if types.Modified(name) {
+		nft.Name = name
+	}
+	if types.Modified(uri) {
+		nft.Uri = uri
+	}
+	if types.Modified(data) {
+		nft.Data = data
+	}
+
+	k.setNFT(ctx, denomID, nft)
+	return nil
+}
+
+// TransferOwner transfers a token held by the owner to the recipient.
+func (k Keeper) TransferOwner(ctx sdk.Context, denomID, tokenID string, owner, recipient sdk.AccAddress) error {
+	nft, err := k.authorize(ctx, denomID, tokenID, owner)
+	if err != nil {
+		return err
+	}
+
+	nft.Owner = recipient.String()
+	k.setNFT(ctx, denomID, nft)
+	k.deleteOwner(ctx, owner, denomID, tokenID)
+	k.setOwner(ctx, recipient, denomID, tokenID)
+	return nil
+}
+
+// BurnNFT burns a token held by the owner.
+func (k Keeper) BurnNFT(ctx sdk.Context, denomID, tokenID string, owner sdk.AccAddress) error {
+	if _, err := k.authorize(ctx, denomID, tokenID, owner); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.NFTKey(denomID, tokenID))
+	k.deleteOwner(ctx, owner, denomID, tokenID)
+	k.setSupply(ctx, denomID, k.GetSupply(ctx, denomID)-1)
+	return nil
+}
+
+// HasNFT returns whether the token exists.
+func (k Keeper) HasNFT(ctx sdk.Context, denomID, tokenID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.NFTKey(denomID, tokenID))
+}
+
+// GetNFT returns the token with the given denom and token id.
+func (k Keeper) GetNFT(ctx sdk.Context, denomID, tokenID string) (types.BaseNFT, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.NFTKey(denomID, tokenID))
+	if bz == nil {
+		return types.BaseNFT{}, sdkerrors.Wrapf(types.ErrUnknownNFT, "nft %s/%s", denomID, tokenID)
+	}
+
+	var nft types.BaseNFT
+	k.cdc.MustUnmarshal(bz, &nft)
+	return nft, nil
+}
+
+// IterateNFTs iterates over the tokens of the denom in token id order until
+// cb returns true.
+func (k Keeper) IterateNFTs(ctx sdk.Context, denomID string, cb func(nft types.BaseNFT) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.CollectionKey(denomID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.BaseNFT
+		k.cdc.MustUnmarshal(iterator.Value(), &nft)
+		if cb(nft) {
+			break
+		}
+	}
+}
+
+// GetCollection returns the denom and all its tokens.
+func (k Keeper) GetCollection(ctx sdk.Context, denomID string) (types.Collection, error) {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return types.Collection{}, err
+	}
+
+	collection := types.Collection{Denom: denom, Nfts: []types.BaseNFT{}}
+	k.IterateNFTs(ctx, denomID, func(nft types.BaseNFT) bool {
+		collection.Nfts = append(collection.Nfts, nft)
+		return false
+	})
+	return collection, nil
+}
+
+// authorize returns the token if the owner holds it.
+func (k Keeper) authorize(ctx sdk.Context, denomID, tokenID string, owner sdk.AccAddress) (types.BaseNFT, error) {
+	nft, err := k.GetNFT(ctx, denomID, tokenID)
+	if err != nil {
+		return types.BaseNFT{}, err
+	}
+	if nft.Owner != owner.String() {
+		return types.BaseNFT{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the owner of nft %s/%s", owner, denomID, tokenID)
+	}
+	return nft, nil
+}
+
+func (k Keeper) setNFT(ctx sdk.Context, denomID string, nft types.BaseNFT) {
+	ctx.KVStore(k.storeKey).Set(types.NFTKey(denomID, nft.Id), k.cdc.MustMarshal(&nft))
+}
+
+func (k Keeper) setOwner(ctx sdk.Context, owner sdk.AccAddress, denomID, tokenID string) {
+	ctx.KVStore(k.storeKey).Set(types.OwnerNFTKey(owner, denomID, tokenID), []byte{0x01})
+}
+
+func (k Keeper) deleteOwner(ctx sdk.Context, owner sdk.AccAddress, denomID, tokenID string) {
+	ctx.KVStore(k.storeKey).Delete(types.OwnerNFTKey(owner, denomID, tokenID))
+}
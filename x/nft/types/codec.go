@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgIssueDenom{}, "nft/IssueDenom", nil)
+	cdc.RegisterConcrete(&MsgMintNFT{}, "nft/MintNFT", nil)
+	cdc.RegisterConcrete(&MsgEditNFT{}, "nft/EditNFT", nil)
+	cdc.RegisterConcrete(&MsgTransferNFT{}, "nft/TransferNFT", nil)
+	cdc.RegisterConcrete(&MsgBurnNFT{}, "nft/BurnNFT", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgIssueDenom{},
+		&MsgMintNFT{},
+		&MsgEditNFT{},
+		&MsgTransferNFT{},
+		&MsgBurnNFT{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,20 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/nft module sentinel errors
+var (
+	ErrInvalidDenom      = sdkerrors.Register(ModuleName, 1100, "invalid denom")
+	ErrDenomExists       = sdkerrors.Register(ModuleName, 1101, "denom already exists")
+	ErrUnknownDenom      = sdkerrors.Register(ModuleName, 1102, "unknown denom")
+	ErrInvalidTokenID    = sdkerrors.Register(ModuleName, 1103, "invalid token id")
+	ErrNFTExists         = sdkerrors.Register(ModuleName, 1104, "nft already exists")
+	ErrUnknownNFT        = sdkerrors.Register(ModuleName, 1105, "unknown nft")
+	ErrInvalidTokenURI   = sdkerrors.Register(ModuleName, 1106, "invalid token uri")
+	ErrUnauthorized      = sdkerrors.Register(ModuleName, 1107, "unauthorized address")
+	ErrInvalidCollection = sdkerrors.Register(ModuleName, 1108, "invalid collection")
+)
@@ -0,0 +1,19 @@
+package types
+
+// nft module event types
+const (
+	EventTypeIssueDenom  = "issue_denom"
+	EventTypeMintNFT     = "mint_nft"
+	EventTypeEditNFT     = "edit_nft"
+	EventTypeTransferNFT = "transfer_nft"
+	EventTypeBurnNFT     = "burn_nft"
+
+	AttributeKeyDenomID   = "denom_id"
+	AttributeKeyDenomName = "denom_name"
+	AttributeKeyCreator   = "creator"
+	AttributeKeyTokenID   = "token_id"
+	AttributeKeyTokenURI  = "token_uri"
+	AttributeKeyOwner     = "owner"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+)
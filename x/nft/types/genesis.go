@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(collections []Collection) *GenesisState {
+	return &GenesisState{Collections: collections}
+}
+
+// DefaultGenesis returns the default nft genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState([]Collection{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	denoms := make(map[string]bool, len(gs.Collections))
+	for _, collection := range gs.Collections {
+		if err := collection.Validate(); err != nil {
+			return err
+		}
+		if denoms[collection.Denom.Id] {
+			return sdkerrors.Wrapf(ErrDenomExists, "duplicate denom %s", collection.Denom.Id)
+		}
+		denoms[collection.Denom.Id] = true
+	}
+	return nil
+}
+
+// Validate checks the denom of a collection and its tokens.
+func (c Collection) Validate() error {
+	if err := ValidateDenomID(c.Denom.Id); err != nil {
+		return err
+	}
+	if err := ValidateDenomName(c.Denom.Name); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Denom.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator of denom %s (%s)", c.Denom.Id, err)
+	}
+
+	tokens := make(map[string]bool, len(c.Nfts))
+	for _, nft := range c.Nfts {
+		if err := ValidateTokenID(nft.Id); err != nil {
+			return err
+		}
+		if err := ValidateTokenURI(nft.Uri); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(nft.Owner); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner of nft %s/%s (%s)", c.Denom.Id, nft.Id, err)
+		}
+		if tokens[nft.Id] {
+			return sdkerrors.Wrapf(ErrNFTExists, "duplicate nft %s/%s", c.Denom.Id, nft.Id)
+		}
+		tokens[nft.Id] = true
+	}
+	return nil
+}
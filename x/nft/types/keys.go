@@ -0,0 +1,80 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "nft"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for nft
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// DenomKeyPrefix prefixes the denoms, stored under DenomKeyPrefix | denom id.
+	DenomKeyPrefix = []byte{0x01}
+
+	// NFTKeyPrefix prefixes the tokens, stored under
+	// NFTKeyPrefix | len(denom id) | denom id | token id.
+	NFTKeyPrefix = []byte{0x02}
+
+	// OwnerKeyPrefix prefixes the owner index, an empty value stored under
+	// OwnerKeyPrefix | len(owner) | owner | len(denom id) | denom id | token id.
+	OwnerKeyPrefix = []byte{0x03}
+
+	// SupplyKeyPrefix prefixes the number of tokens of a denom, a big endian
+	// uint64 stored under SupplyKeyPrefix | denom id. Added in version 2.
+	SupplyKeyPrefix = []byte{0x04}
+)
+
+// DenomKey returns the key of the denom with the given id.
+func DenomKey(denomID string) []byte {
+	return append(append([]byte{}, DenomKeyPrefix...), []byte(denomID)...)
+}
+
+// CollectionKey returns the prefix of all tokens of the given denom.
+func CollectionKey(denomID string) []byte {
+	return append(append([]byte{}, NFTKeyPrefix...), lengthPrefixed([]byte(denomID))...)
+}
+
+// NFTKey returns the key of the token with the given denom and token id.
+func NFTKey(denomID, tokenID string) []byte {
+	return append(CollectionKey(denomID), []byte(tokenID)...)
+}
+
+// OwnerKey returns the prefix of the tokens of the given owner.
+func OwnerKey(owner sdk.AccAddress) []byte {
+	return append(append([]byte{}, OwnerKeyPrefix...), lengthPrefixed(owner)...)
+}
+
+// OwnerDenomKey returns the prefix of the tokens of the given owner and denom.
+func OwnerDenomKey(owner sdk.AccAddress, denomID string) []byte {
+	return append(OwnerKey(owner), lengthPrefixed([]byte(denomID))...)
+}
+
+// OwnerNFTKey returns the owner index key of the given token.
+func OwnerNFTKey(owner sdk.AccAddress, denomID, tokenID string) []byte {
+	return append(OwnerDenomKey(owner, denomID), []byte(tokenID)...)
+}
+
+// SplitOwnerNFTKey returns the denom and token id of an owner index key
+// without the OwnerKey prefix.
+func SplitOwnerNFTKey(key []byte) (denomID, tokenID string) {
+	denomLen := int(key[0])
+	return string(key[1 : 1+denomLen]), string(key[1+denomLen:])
+}
+
+// SupplyKey returns the key of the number of tokens of the given denom.
+func SupplyKey(denomID string) []byte {
+	return append(append([]byte{}, SupplyKeyPrefix...), []byte(denomID)...)
+}
+
+func lengthPrefixed(bz []byte) []byte {
+	return append([]byte{byte(len(bz))}, bz...)
+}
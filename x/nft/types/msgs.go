@@ -0,0 +1,232 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgIssueDenom  = "issue_denom"
+	TypeMsgMintNFT     = "mint_nft"
+	TypeMsgEditNFT     = "edit_nft"
+	TypeMsgTransferNFT = "transfer_nft"
+	TypeMsgBurnNFT     = "burn_nft"
+)
+
+var (
+	_ sdk.Msg = &MsgIssueDenom{}
+	_ sdk.Msg = &MsgMintNFT{}
+	_ sdk.Msg = &MsgEditNFT{}
+	_ sdk.Msg = &MsgTransferNFT{}
+	_ sdk.Msg = &MsgBurnNFT{}
+)
+
+func NewMsgIssueDenom(denomID, name, schema, sender, symbol, description, data string) *MsgIssueDenom {
+	return &MsgIssueDenom{
+		Id:          denomID,
+		Name:        name,
+		Schema:      schema,
+		Sender:      sender,
+		Symbol:      symbol,
+		Description: description,
+		Data:        data,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgIssueDenom) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueDenom) Type() string { return TypeMsgIssueDenom }
+
+// ValidateBasic Implements Msg.
+func (msg MsgIssueDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if err := ValidateDenomID(msg.Id); err != nil {
+		return err
+	}
+	return ValidateDenomName(msg.Name)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Sender)}
+}
+
+func NewMsgMintNFT(tokenID, denomID, name, uri, data, sender, recipient string) *MsgMintNFT {
+	return &MsgMintNFT{
+		Id:        tokenID,
+		DenomId:   denomID,
+		Name:      name,
+		Uri:       uri,
+		Data:      data,
+		Sender:    sender,
+		Recipient: recipient,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgMintNFT) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgMintNFT) Type() string { return TypeMsgMintNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgMintNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateTokenID(msg.Id); err != nil {
+		return err
+	}
+	return ValidateTokenURI(msg.Uri)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgMintNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgMintNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Sender)}
+}
+
+func NewMsgEditNFT(tokenID, denomID, name, uri, data, sender string) *MsgEditNFT {
+	return &MsgEditNFT{
+		Id:      tokenID,
+		DenomId: denomID,
+		Name:    name,
+		Uri:     uri,
+		Data:    data,
+		Sender:  sender,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgEditNFT) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgEditNFT) Type() string { return TypeMsgEditNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgEditNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateTokenID(msg.Id); err != nil {
+		return err
+	}
+	if Modified(msg.Uri) {
+		return ValidateTokenURI(msg.Uri)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgEditNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgEditNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Sender)}
+}
+
+func NewMsgTransferNFT(tokenID, denomID, sender, recipient string) *MsgTransferNFT {
+	return &MsgTransferNFT{
+		Id:        tokenID,
+		DenomId:   denomID,
+		Sender:    sender,
+		Recipient: recipient,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgTransferNFT) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgTransferNFT) Type() string { return TypeMsgTransferNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTransferNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateTokenID(msg.Id)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTransferNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTransferNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Sender)}
+}
+
+func NewMsgBurnNFT(tokenID, denomID, sender string) *MsgBurnNFT {
+	return &MsgBurnNFT{
+		Id:      tokenID,
+		DenomId: denomID,
+		Sender:  sender,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgBurnNFT) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBurnNFT) Type() string { return TypeMsgBurnNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurnNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateTokenID(msg.Id)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurnNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurnNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Sender)}
+}
+
+func mustAccAddress(addr string) sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		panic(err)
+	}
+	return accAddr
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DoNotModify marks the fields of MsgEditNFT that keep their value.
+	DoNotModify = "[do-not-modify]"
+
+	MaxURILength = 256
+)
+
+var (
+	// denom ids are lowercase so that they can be used in REST paths
+	denomIDRegex = regexp.MustCompile(`^[a-z][a-z0-9]{2,63}$`)
+	tokenIDRegex = regexp.MustCompile(`^[a-zA-Z0-9]{1,64}$`)
+)
+
+// ValidateDenomID checks that a denom id is 3 to 64 lowercase alphanumeric
+// characters starting with a letter.
+func ValidateDenomID(denomID string) error {
+	if !denomIDRegex.MatchString(denomID) {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "denom id %q must match %s", denomID, denomIDRegex)
+	}
+	return nil
+}
+
+// ValidateDenomName checks that a denom has a name.
+func ValidateDenomName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return sdkerrors.Wrap(ErrInvalidDenom, "denom name cannot be blank")
+	}
+	return nil
+}
+
+// ValidateTokenID checks that a token id is 1 to 64 alphanumeric characters.
+func ValidateTokenID(tokenID string) error {
+	if !tokenIDRegex.MatchString(tokenID) {
+		return sdkerrors.Wrapf(ErrInvalidTokenID, "token id %q must match %s", tokenID, tokenIDRegex)
+	}
+	return nil
+}
+
+// ValidateTokenURI checks the length of a token uri.
+func ValidateTokenURI(uri string) error {
+	if len(uri) > MaxURILength {
+		return sdkerrors.Wrapf(ErrInvalidTokenURI, "token uri is longer than %d characters", MaxURILength)
+	}
+	return nil
+}
+
+// Modified returns whether a field of MsgEditNFT changes the token.
+func Modified(field string) bool {
+	return field != DoNotModify
+}
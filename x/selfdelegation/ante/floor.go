@@ -0,0 +1,24 @@
+package ante
+
+import (
+	"github.com/CudoVentures/cudos-node/x/selfdelegation/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FloorDecorator rejects transactions that create, edit or unjail a validator
+// or undelegate or redelegate from it in breach of the self-delegation floor.
+type FloorDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewFloorDecorator(k keeper.Keeper) FloorDecorator {
+	return FloorDecorator{keeper: k}
+}
+
+func (d FloorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.keeper.CheckMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
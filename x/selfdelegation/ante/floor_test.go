@@ -0,0 +1,110 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/selfdelegation/ante"
+	"github.com/CudoVentures/cudos-node/x/selfdelegation/keeper"
+	"github.com/CudoVentures/cudos-node/x/selfdelegation/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	operator = sdk.ValAddress([]byte("operator____________"))
+	other    = sdk.ValAddress([]byte("other_______________"))
+)
+
+// mockStakingKeeper holds a single validator its operator delegates 500 to.
+type mockStakingKeeper struct {
+	validator stakingtypes.Validator
+}
+
+func (sk mockStakingKeeper) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	return sk.validator, addr.Equals(operator)
+}
+
+func (sk mockStakingKeeper) GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool) {
+	if !delAddr.Equals(operator) || !valAddr.Equals(operator) {
+		return stakingtypes.Delegation{}, false
+	}
+	return stakingtypes.NewDelegation(delAddr, valAddr, sk.validator.DelegatorShares), true
+}
+
+func (sk mockStakingKeeper) IterateValidators(sdk.Context, func(int64, stakingtypes.ValidatorI) bool) {
+}
+
+func (sk mockStakingKeeper) Jail(sdk.Context, sdk.ConsAddress) {}
+
+type mockTx []sdk.Msg
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestFloorDecorator(t *testing.T) {
+	validator, err := stakingtypes.NewValidator(operator, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(500))
+
+	stores := keepertest.NewStores()
+	k := keeper.NewKeeper(stores.Subspace(types.ModuleName), mockStakingKeeper{validator: validator})
+	ctx := stores.Context(t, tmproto.Header{})
+	k.SetParams(ctx, types.NewParams(sdk.NewInt(100)))
+
+	stake := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount) }
+	undelegate := stakingtypes.NewMsgUndelegate(sdk.AccAddress(operator), operator, stake(401))
+	redelegate := stakingtypes.NewMsgBeginRedelegate(sdk.AccAddress(operator), operator, other, stake(401))
+	proposal, err := group.NewMsgSubmitProposal(sdk.AccAddress(other).String(), []string{sdk.AccAddress(other).String()}, []sdk.Msg{redelegate}, "", group.Exec_EXEC_UNSPECIFIED)
+	require.NoError(t, err)
+	exec := authz.NewMsgExec(sdk.AccAddress(other), []sdk.Msg{undelegate})
+	half := stakingtypes.NewMsgUndelegate(sdk.AccAddress(operator), operator, stake(201))
+	halfProposal, err := group.NewMsgSubmitProposal(sdk.AccAddress(other).String(), []string{sdk.AccAddress(other).String()}, []sdk.Msg{half}, "", group.Exec_EXEC_UNSPECIFIED)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name string
+		msgs []sdk.Msg
+		err  bool
+	}{
+		{name: "create at the floor", msgs: []sdk.Msg{&stakingtypes.MsgCreateValidator{Value: stake(100)}}},
+		{name: "create below the floor", msgs: []sdk.Msg{&stakingtypes.MsgCreateValidator{Value: stake(99)}}, err: true},
+		{name: "undelegate above the floor", msgs: []sdk.Msg{stakingtypes.NewMsgUndelegate(sdk.AccAddress(operator), operator, stake(400))}},
+		{name: "undelegate below the floor", msgs: []sdk.Msg{undelegate}, err: true},
+		{name: "redelegate below the floor", msgs: []sdk.Msg{redelegate}, err: true},
+		{name: "undelegate in authz exec", msgs: []sdk.Msg{&exec}, err: true},
+		{name: "redelegate in group proposal", msgs: []sdk.Msg{proposal}, err: true},
+		{name: "undelegations adding up below the floor", msgs: []sdk.Msg{half, half}, err: true},
+		{name: "undelegations adding up below the floor in a group proposal", msgs: []sdk.Msg{half, halfProposal}, err: true},
+		{name: "undelegations adding up to everything", msgs: []sdk.Msg{half, half, stakingtypes.NewMsgUndelegate(sdk.AccAddress(operator), operator, stake(98))}},
+		{name: "other messages", msgs: []sdk.Msg{stakingtypes.NewMsgDelegate(sdk.AccAddress(other), operator, stake(1))}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			called := false
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				called = true
+				return ctx, nil
+			}
+
+			_, err := ante.NewFloorDecorator(k).AnteHandle(ctx, mockTx(tc.msgs), false, next)
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrBelowFloor)
+				require.False(t, called)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, called)
+		})
+	}
+
+	// a zero floor is disabled
+	k.SetParams(ctx, types.DefaultParams())
+	_, err = ante.NewFloorDecorator(k).AnteHandle(ctx, mockTx{redelegate}, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+	require.NoError(t, err)
+}
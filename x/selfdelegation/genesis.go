@@ -0,0 +1,18 @@
+package selfdelegation
+
+import (
+	"github.com/CudoVentures/cudos-node/x/selfdelegation/keeper"
+	"github.com/CudoVentures/cudos-node/x/selfdelegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the selfdelegation module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis returns the selfdelegation module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
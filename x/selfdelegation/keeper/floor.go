@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/CudoVentures/cudos-node/x/selfdelegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SelfDelegation returns the tokens the operator of a validator delegates to
+// it.
+func (k Keeper) SelfDelegation(ctx sdk.Context, validator stakingtypes.ValidatorI) sdk.Int {
+	operator := validator.GetOperator()
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(operator), operator)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// CheckMsgs applies the floor to every message creating, editing, unjailing
+// a validator or undelegating or redelegating from it in msgs, including the
+// ones nested in authz executions and group proposals. The undelegations and
+// redelegations of an operator are added up over all of msgs.
+func (k Keeper) CheckMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	params := k.GetParams(ctx)
+	if !params.MinSelfDelegation.IsPositive() {
+		return nil
+	}
+
+	unbonded := make(map[string]sdk.Int)
+	if err := k.checkMsgs(ctx, params, msgs, unbonded); err != nil {
+		return err
+	}
+
+	operators := make([]string, 0, len(unbonded))
+	for operator := range unbonded {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+	for _, operator := range operators {
+		if err := k.checkUnbond(ctx, params, mustValAddress(operator), unbonded[operator]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkMsgs checks msgs, adding the amounts operators undelegate or
+// redelegate from their own validator to unbonded.
+func (k Keeper) checkMsgs(ctx sdk.Context, params types.Params, msgs []sdk.Msg, unbonded map[string]sdk.Int) error {
+	for _, msg := range msgs {
+		var nested []sdk.Msg
+		var err error
+
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgCreateValidator:
+			if params.IsBelowFloor(msg.Value.Amount) {
+				return sdkerrors.Wrapf(types.ErrBelowFloor, "the self-delegation %s is lower than %s", msg.Value.Amount, params.MinSelfDelegation)
+			}
+			continue
+		case *stakingtypes.MsgEditValidator:
+			if msg.MinSelfDelegation != nil && params.IsBelowFloor(*msg.MinSelfDelegation) {
+				return sdkerrors.Wrapf(types.ErrBelowFloor, "the minimum self-delegation %s is lower than %s", msg.MinSelfDelegation, params.MinSelfDelegation)
+			}
+			continue
+		case *stakingtypes.MsgUndelegate:
+			if err := addUnbond(unbonded, msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount.Amount); err != nil {
+				return err
+			}
+			continue
+		case *stakingtypes.MsgBeginRedelegate:
+			if err := addUnbond(unbonded, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.Amount.Amount); err != nil {
+				return err
+			}
+			continue
+		case *slashingtypes.MsgUnjail:
+			if err := k.checkUnjail(ctx, params, msg); err != nil {
+				return err
+			}
+			continue
+		case *authz.MsgExec:
+			nested, err = msg.GetMessages()
+		case *group.MsgSubmitProposal:
+			nested, err = msg.GetMsgs()
+		default:
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+		if err := k.checkMsgs(ctx, params, nested, unbonded); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addUnbond adds amount to what the delegator unbonds from the validator when
+// it is the operator of the validator.
+func addUnbond(unbonded map[string]sdk.Int, delegatorAddr, validatorAddr string, amount sdk.Int) error {
+	delegator, err := sdk.AccAddressFromBech32(delegatorAddr)
+	if err != nil {
+		return err
+	}
+	operator, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return err
+	}
+	if !operator.Equals(sdk.ValAddress(delegator)) {
+		return nil
+	}
+
+	if previous, ok := unbonded[operator.String()]; ok {
+		amount = amount.Add(previous)
+	}
+	unbonded[operator.String()] = amount
+	return nil
+}
+
+// checkUnbond rejects operators undelegating or redelegating amount from
+// their own validator below the floor. Unbonding everything is allowed, the
+// validator is jailed by staking then.
+func (k Keeper) checkUnbond(ctx sdk.Context, params types.Params, operator sdk.ValAddress, amount sdk.Int) error {
+	validator, found := k.stakingKeeper.GetValidator(ctx, operator)
+	if !found {
+		return nil
+	}
+
+	remaining := k.SelfDelegation(ctx, validator).Sub(amount)
+	if remaining.IsPositive() && params.IsBelowFloor(remaining) {
+		return sdkerrors.Wrapf(types.ErrBelowFloor, "the remaining self-delegation %s is lower than %s", remaining, params.MinSelfDelegation)
+	}
+	return nil
+}
+
+// checkUnjail rejects unjailing a validator whose self-delegation is below
+// the floor, it would be jailed again at the end of the block.
+func (k Keeper) checkUnjail(ctx sdk.Context, params types.Params, msg *slashingtypes.MsgUnjail) error {
+	operator, err := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, operator)
+	if !found {
+		return nil
+	}
+
+	if selfDelegation := k.SelfDelegation(ctx, validator); params.IsBelowFloor(selfDelegation) {
+		return sdkerrors.Wrapf(types.ErrBelowFloor, "the self-delegation %s is lower than %s", selfDelegation, params.MinSelfDelegation)
+	}
+	return nil
+}
+
+// ValidatorsBelow returns the self-delegations of the validators delegating
+// less than threshold to themselves.
+func (k Keeper) ValidatorsBelow(ctx sdk.Context, threshold sdk.Dec) []types.ValidatorSelfDelegation {
+	validators := []types.ValidatorSelfDelegation{}
+	k.stakingKeeper.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		selfDelegation := k.SelfDelegation(ctx, validator)
+		if selfDelegation.ToDec().LT(threshold) {
+			validators = append(validators, types.ValidatorSelfDelegation{
+				OperatorAddress: validator.GetOperator().String(),
+				SelfDelegation:  selfDelegation,
+				Jailed:          validator.IsJailed(),
+			})
+		}
+		return false
+	})
+	return validators
+}
+
+// JailValidatorsBelowFloor jails the validators whose self-delegation fell
+// below the floor.
+func (k Keeper) JailValidatorsBelowFloor(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.MinSelfDelegation.IsPositive() {
+		return
+	}
+
+	for _, below := range k.ValidatorsBelow(ctx, params.MinSelfDelegation.ToDec()) {
+		if below.Jailed {
+			continue
+		}
+
+		validator, _ := k.stakingKeeper.GetValidator(ctx, mustValAddress(below.OperatorAddress))
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			k.Logger(ctx).Error("failed to jail validator below the self-delegation floor", "validator", below.OperatorAddress, "err", err)
+			continue
+		}
+		k.stakingKeeper.Jail(ctx, consAddr)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeJailBelowFloor,
+			sdk.NewAttribute(types.AttributeKeyValidator, below.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeySelfDelegation, below.SelfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyFloor, params.MinSelfDelegation.String()),
+		))
+		k.Logger(ctx).Info("jailed validator below the self-delegation floor", "validator", below.OperatorAddress, "self_delegation", below.SelfDelegation)
+	}
+}
+
+func mustValAddress(operator string) sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(operator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
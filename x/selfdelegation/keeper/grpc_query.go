@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/CudoVentures/cudos-node/x/selfdelegation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultAtRiskMargin reports the validators less than 10% above the floor
+// as at risk.
+var defaultAtRiskMargin = sdk.NewDecWithPrec(1, 1)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) AtRiskValidators(goCtx context.Context, req *types.QueryAtRiskValidatorsRequest) (*types.QueryAtRiskValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	margin := defaultAtRiskMargin
+	if req.Margin != "" {
+		var err error
+		margin, err = sdk.NewDecFromStr(req.Margin)
+		if err != nil || margin.IsNegative() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid margin %s", req.Margin)
+		}
+	}
+
+	floor := k.GetParams(ctx).MinSelfDelegation
+	if !floor.IsPositive() {
+		return &types.QueryAtRiskValidatorsResponse{Validators: []types.ValidatorSelfDelegation{}}, nil
+	}
+
+	threshold := floor.ToDec().Mul(sdk.OneDec().Add(margin))
+	return &types.QueryAtRiskValidatorsResponse{Validators: k.ValidatorsBelow(ctx, threshold)}, nil
+}
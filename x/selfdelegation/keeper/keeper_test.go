@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/selfdelegation/keeper"
+	"github.com/CudoVentures/cudos-node/x/selfdelegation/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	rich = sdk.ValAddress([]byte("rich________________"))
+	poor = sdk.ValAddress([]byte("poor________________"))
+)
+
+type mockStakingKeeper struct {
+	validators  []stakingtypes.Validator
+	delegations map[string]stakingtypes.Delegation
+}
+
+func (sk *mockStakingKeeper) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	for _, validator := range sk.validators {
+		if validator.GetOperator().Equals(addr) {
+			return validator, true
+		}
+	}
+	return stakingtypes.Validator{}, false
+}
+
+func (sk *mockStakingKeeper) GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool) {
+	delegation, found := sk.delegations[delAddr.String()+valAddr.String()]
+	return delegation, found
+}
+
+func (sk *mockStakingKeeper) IterateValidators(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+	for i, validator := range sk.validators {
+		if fn(int64(i), validator) {
+			return
+		}
+	}
+}
+
+func (sk *mockStakingKeeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	for i, validator := range sk.validators {
+		if addr, _ := validator.GetConsAddr(); addr.Equals(consAddr) {
+			sk.validators[i].Jailed = true
+		}
+	}
+}
+
+// addValidator adds a validator its operator delegates selfDelegation to,
+// next to a delegation of another account.
+func (sk *mockStakingKeeper) addValidator(t *testing.T, operator sdk.ValAddress, selfDelegation int64) {
+	validator, err := stakingtypes.NewValidator(operator, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+
+	validator, shares := validator.AddTokensFromDel(sdk.NewInt(selfDelegation))
+	sk.delegations[sdk.AccAddress(operator).String()+operator.String()] = stakingtypes.NewDelegation(sdk.AccAddress(operator), operator, shares)
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(1000))
+
+	sk.validators = append(sk.validators, validator)
+}
+
+func setupKeeper(t *testing.T) (keeper.Keeper, *mockStakingKeeper, sdk.Context) {
+	stores := keepertest.NewStores()
+	subspace := stores.Subspace(types.ModuleName)
+
+	sk := &mockStakingKeeper{delegations: map[string]stakingtypes.Delegation{}}
+	sk.addValidator(t, rich, 500)
+	sk.addValidator(t, poor, 50)
+	k := keeper.NewKeeper(subspace, sk)
+
+	ctx := stores.Context(t, tmproto.Header{})
+	k.SetParams(ctx, types.NewParams(sdk.NewInt(100)))
+
+	return k, sk, ctx
+}
+
+func TestCheckMsgs(t *testing.T) {
+	k, _, ctx := setupKeeper(t)
+	stake := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount) }
+	undelegate := func(operator sdk.ValAddress, amount int64) *stakingtypes.MsgUndelegate {
+		return stakingtypes.NewMsgUndelegate(sdk.AccAddress(operator), operator, stake(amount))
+	}
+	lowMinSelfDelegation := sdk.NewInt(99)
+
+	for _, tc := range []struct {
+		name string
+		msg  sdk.Msg
+		err  bool
+	}{
+		{"create at the floor", &stakingtypes.MsgCreateValidator{Value: stake(100)}, false},
+		{"create below the floor", &stakingtypes.MsgCreateValidator{Value: stake(99)}, true},
+		{"edit without min self delegation", &stakingtypes.MsgEditValidator{}, false},
+		{"edit min self delegation below the floor", stakingtypes.NewMsgEditValidator(rich, stakingtypes.Description{}, nil, &lowMinSelfDelegation), true},
+		{"undelegate above the floor", undelegate(rich, 400), false},
+		{"undelegate below the floor", undelegate(rich, 401), true},
+		{"undelegate everything", undelegate(rich, 500), false},
+		{"undelegate from another validator", stakingtypes.NewMsgUndelegate(sdk.AccAddress(rich), poor, stake(10)), false},
+		{"redelegate above the floor", stakingtypes.NewMsgBeginRedelegate(sdk.AccAddress(rich), rich, poor, stake(400)), false},
+		{"redelegate below the floor", stakingtypes.NewMsgBeginRedelegate(sdk.AccAddress(rich), rich, poor, stake(401)), true},
+		{"redelegate everything", stakingtypes.NewMsgBeginRedelegate(sdk.AccAddress(rich), rich, poor, stake(500)), false},
+		{"redelegate to the own validator", stakingtypes.NewMsgBeginRedelegate(sdk.AccAddress(poor), rich, poor, stake(10)), false},
+		{"unjail above the floor", slashingtypes.NewMsgUnjail(rich), false},
+		{"unjail below the floor", slashingtypes.NewMsgUnjail(poor), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := k.CheckMsgs(ctx, []sdk.Msg{tc.msg})
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrBelowFloor)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// nested in an authz execution
+	exec := authz.NewMsgExec(sdk.AccAddress(poor), []sdk.Msg{undelegate(rich, 401)})
+	require.ErrorIs(t, k.CheckMsgs(ctx, []sdk.Msg{&exec}), types.ErrBelowFloor)
+
+	// a zero floor is disabled
+	k.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, k.CheckMsgs(ctx, []sdk.Msg{undelegate(rich, 401)}))
+}
+
+func TestJailValidatorsBelowFloor(t *testing.T) {
+	k, sk, ctx := setupKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	res, err := k.AtRiskValidators(goCtx, &types.QueryAtRiskValidatorsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 1)
+	require.Equal(t, poor.String(), res.Validators[0].OperatorAddress)
+	require.Equal(t, sdk.NewInt(50), res.Validators[0].SelfDelegation)
+	require.False(t, res.Validators[0].Jailed)
+
+	// a wide margin reports the validators close to the floor as well
+	res, err = k.AtRiskValidators(goCtx, &types.QueryAtRiskValidatorsRequest{Margin: "5"})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 2)
+
+	k.JailValidatorsBelowFloor(ctx)
+	require.False(t, sk.validators[0].Jailed)
+	require.True(t, sk.validators[1].Jailed)
+
+	var events int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeJailBelowFloor {
+			events++
+		}
+	}
+	require.Equal(t, 1, events)
+
+	// jailed validators are not jailed again
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.JailValidatorsBelowFloor(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+
+	_, err = k.AtRiskValidators(goCtx, &types.QueryAtRiskValidatorsRequest{Margin: "-1"})
+	require.Error(t, err)
+}
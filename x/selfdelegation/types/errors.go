@@ -0,0 +1,12 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/selfdelegation module sentinel errors
+var (
+	ErrBelowFloor = sdkerrors.Register(ModuleName, 1100, "self-delegation below the floor")
+)
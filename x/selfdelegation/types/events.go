@@ -0,0 +1,10 @@
+package types
+
+// selfdelegation module event types
+const (
+	EventTypeJailBelowFloor = "jail_below_self_delegation_floor"
+
+	AttributeKeyValidator      = "validator"
+	AttributeKeySelfDelegation = "self_delegation"
+	AttributeKeyFloor          = "floor"
+)
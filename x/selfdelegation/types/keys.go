@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "selfdelegation"
+
+	// RouterKey is the message route for selfdelegation
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyMinSelfDelegation = []byte("MinSelfDelegation")
+)
+
+// ParamKeyTable ParamTable for selfdelegation module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(minSelfDelegation sdk.Int) Params {
+	return Params{
+		MinSelfDelegation: minSelfDelegation,
+	}
+}
+
+// DefaultParams disables the floor, leaving the minimum self-delegation to
+// each validator as before.
+func DefaultParams() Params {
+	return NewParams(sdk.ZeroInt())
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	return validateMinSelfDelegation(p.MinSelfDelegation)
+}
+
+// IsBelowFloor reports whether a self-delegation is lower than the floor.
+func (p Params) IsBelowFloor(selfDelegation sdk.Int) bool {
+	return selfDelegation.LT(p.MinSelfDelegation)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinSelfDelegation, &p.MinSelfDelegation, validateMinSelfDelegation),
+	}
+}
+
+func validateMinSelfDelegation(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min self delegation must not be negative: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,592 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cudos/selfdelegation/selfdelegation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the self-delegation floor of the chain.
+type Params struct {
+	// min_self_delegation is the amount of the bond denom every validator has
+	// to keep delegated to itself, zero disables the floor.
+	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation" yaml:"min_self_delegation"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce38702c4ca235db, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// ValidatorSelfDelegation is the amount a validator delegates to itself.
+type ValidatorSelfDelegation struct {
+	OperatorAddress string                                 `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	SelfDelegation  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=self_delegation,json=selfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"self_delegation"`
+	Jailed          bool                                   `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+}
+
+func (m *ValidatorSelfDelegation) Reset()         { *m = ValidatorSelfDelegation{} }
+func (m *ValidatorSelfDelegation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSelfDelegation) ProtoMessage()    {}
+func (*ValidatorSelfDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce38702c4ca235db, []int{1}
+}
+func (m *ValidatorSelfDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSelfDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSelfDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSelfDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSelfDelegation.Merge(m, src)
+}
+func (m *ValidatorSelfDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSelfDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSelfDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSelfDelegation proto.InternalMessageInfo
+
+func (m *ValidatorSelfDelegation) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorSelfDelegation) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cudos.selfdelegation.Params")
+	proto.RegisterType((*ValidatorSelfDelegation)(nil), "cudos.selfdelegation.ValidatorSelfDelegation")
+}
+
+func init() {
+	proto.RegisterFile("cudos/selfdelegation/selfdelegation.proto", fileDescriptor_ce38702c4ca235db)
+}
+
+var fileDescriptor_ce38702c4ca235db = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0xb1, 0x4e, 0x72, 0x31,
+	0x18, 0xbd, 0xfd, 0xff, 0x84, 0x68, 0x07, 0xd1, 0x2b, 0x51, 0xc2, 0x70, 0x21, 0x0c, 0x06, 0x06,
+	0x6e, 0x07, 0x27, 0xdd, 0x44, 0x17, 0x13, 0x07, 0x73, 0x49, 0x30, 0x71, 0x21, 0x85, 0x96, 0x6b,
+	0xb5, 0xed, 0x47, 0xda, 0xde, 0x44, 0x12, 0x67, 0x67, 0x5f, 0xc6, 0x77, 0x60, 0x64, 0x34, 0x0e,
+	0xc4, 0xc0, 0x1b, 0xf8, 0x04, 0x86, 0x82, 0x41, 0x88, 0x8b, 0x53, 0xfb, 0x9d, 0x73, 0xbe, 0xf6,
+	0x9c, 0x1c, 0x5c, 0xef, 0x65, 0x0c, 0x2c, 0xb1, 0x5c, 0xf6, 0x19, 0x97, 0x3c, 0xa5, 0x4e, 0x80,
+	0xde, 0x18, 0xe3, 0x81, 0x01, 0x07, 0x61, 0xc1, 0x4b, 0xe3, 0x75, 0xae, 0x54, 0x48, 0x21, 0x05,
+	0x2f, 0x20, 0xf3, 0xdb, 0x42, 0x5b, 0x7d, 0x46, 0x38, 0x77, 0x4d, 0x0d, 0x55, 0x36, 0x7c, 0xc2,
+	0xfb, 0x4a, 0xe8, 0xce, 0x7c, 0xad, 0xb3, 0xda, 0x2b, 0xa2, 0x0a, 0xaa, 0x6d, 0x37, 0xaf, 0x46,
+	0x93, 0x72, 0xf0, 0x3e, 0x29, 0x1f, 0xa5, 0xc2, 0xdd, 0x65, 0xdd, 0xb8, 0x07, 0x8a, 0xf4, 0xc0,
+	0x2a, 0xb0, 0xcb, 0xa3, 0x61, 0xd9, 0x03, 0x71, 0xc3, 0x01, 0xb7, 0xf1, 0xa5, 0x76, 0x9f, 0x93,
+	0x72, 0x69, 0x48, 0x95, 0x3c, 0xad, 0xfe, 0xf2, 0x64, 0x35, 0xd9, 0x53, 0x42, 0xb7, 0xb8, 0xec,
+	0x5f, 0xac, 0xb0, 0x57, 0x84, 0x0f, 0xdb, 0x54, 0x0a, 0x46, 0x1d, 0x98, 0x75, 0x2e, 0xac, 0xe3,
+	0x5d, 0x18, 0x70, 0x33, 0x67, 0x3a, 0x94, 0x31, 0xc3, 0xad, 0x5d, 0xd8, 0x4a, 0xf2, 0xdf, 0xf8,
+	0xd9, 0x02, 0x0e, 0x6f, 0x70, 0x7e, 0x33, 0xc0, 0x3f, 0x1f, 0x20, 0xfe, 0x5b, 0x80, 0x64, 0xc7,
+	0xae, 0x7b, 0x38, 0xc0, 0xb9, 0x7b, 0x2a, 0x24, 0x67, 0xc5, 0xff, 0x15, 0x54, 0xdb, 0x4a, 0x96,
+	0x53, 0xb3, 0x35, 0x9a, 0x46, 0x68, 0x3c, 0x8d, 0xd0, 0xc7, 0x34, 0x42, 0x2f, 0xb3, 0x28, 0x18,
+	0xcf, 0xa2, 0xe0, 0x6d, 0x16, 0x05, 0xb7, 0x27, 0x3f, 0x7e, 0x3a, 0xcf, 0x18, 0xb4, 0xb9, 0x76,
+	0x99, 0xe1, 0x96, 0xf8, 0x7a, 0x1a, 0x1a, 0x18, 0x27, 0x8f, 0x9b, 0x85, 0x7a, 0x03, 0xdd, 0x9c,
+	0x2f, 0xe7, 0xf8, 0x6b, 0x00, 0xfd, 0xbe, 0xae, 0xc4, 0xf5, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSelfdelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSelfDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSelfDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSelfDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.SelfDelegation.Size()
+		i -= size
+		if _, err := m.SelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSelfdelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintSelfdelegation(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSelfdelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSelfdelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovSelfdelegation(uint64(l))
+	return n
+}
+
+func (m *ValidatorSelfDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSelfdelegation(uint64(l))
+	}
+	l = m.SelfDelegation.Size()
+	n += 1 + l + sovSelfdelegation(uint64(l))
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func sovSelfdelegation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSelfdelegation(x uint64) (n int) {
+	return sovSelfdelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSelfdelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSelfdelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSelfdelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSelfdelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSelfdelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSelfdelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSelfdelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSelfDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSelfdelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSelfDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSelfDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSelfdelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSelfdelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSelfdelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSelfdelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSelfdelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSelfdelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSelfdelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSelfdelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSelfdelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSelfdelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSelfdelegation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSelfdelegation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSelfdelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSelfdelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSelfdelegation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSelfdelegation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSelfdelegation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSelfdelegation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSelfdelegation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSelfdelegation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,24 @@
+package ante
+
+import (
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateValidatorDecorator rejects transactions creating a validator for an
+// operator that is not on the allowlist, before they pay for the execution.
+type CreateValidatorDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewCreateValidatorDecorator(k keeper.Keeper) CreateValidatorDecorator {
+	return CreateValidatorDecorator{keeper: k}
+}
+
+func (d CreateValidatorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.keeper.CheckCreateValidators(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
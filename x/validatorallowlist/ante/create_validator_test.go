@@ -0,0 +1,94 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/ante"
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/keeper"
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	alice  = sdk.AccAddress([]byte("alice_______________"))
+	policy = sdk.AccAddress([]byte("policy______________"))
+	listed = sdk.ValAddress([]byte("listed______________"))
+	other  = sdk.ValAddress([]byte("other_______________"))
+)
+
+type mockAdminKeeper struct{}
+
+func (mockAdminKeeper) IsAdmin(sdk.Context, sdk.AccAddress) bool { return false }
+
+type mockStakingKeeper struct{}
+
+func (mockStakingKeeper) IterateValidators(sdk.Context, func(int64, stakingtypes.ValidatorI) bool) {}
+
+type mockTx []sdk.Msg
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func msgCreateValidator(operator sdk.ValAddress) *stakingtypes.MsgCreateValidator {
+	return &stakingtypes.MsgCreateValidator{ValidatorAddress: operator.String()}
+}
+
+func authzExec(msgs ...sdk.Msg) *authz.MsgExec {
+	exec := authz.NewMsgExec(alice, msgs)
+	return &exec
+}
+
+func groupProposal(t *testing.T, msgs ...sdk.Msg) *group.MsgSubmitProposal {
+	msg, err := group.NewMsgSubmitProposal(policy.String(), []string{alice.String()}, msgs, "", group.Exec_EXEC_UNSPECIFIED)
+	require.NoError(t, err)
+	return msg
+}
+
+func TestCreateValidatorDecorator(t *testing.T) {
+	stores := keepertest.NewStores()
+	k := keeper.NewKeeper(stores.Codec, stores.KVStoreKey(types.StoreKey), stores.Subspace(types.ModuleName), mockAdminKeeper{}, mockStakingKeeper{})
+	ctx := stores.Context(t, tmproto.Header{})
+	k.SetParams(ctx, types.DefaultParams())
+	k.Allow(ctx, listed)
+
+	for _, tc := range []struct {
+		name string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{name: "allowed operator", msgs: []sdk.Msg{msgCreateValidator(listed)}},
+		{name: "operator not allowed", msgs: []sdk.Msg{msgCreateValidator(other)}, err: types.ErrOperatorNotAllowed},
+		{name: "after other messages", msgs: []sdk.Msg{msgCreateValidator(listed), msgCreateValidator(other)}, err: types.ErrOperatorNotAllowed},
+		{name: "allowed in authz exec", msgs: []sdk.Msg{authzExec(msgCreateValidator(listed))}},
+		{name: "in authz exec", msgs: []sdk.Msg{authzExec(msgCreateValidator(other))}, err: types.ErrOperatorNotAllowed},
+		{name: "in nested authz exec", msgs: []sdk.Msg{authzExec(authzExec(msgCreateValidator(other)))}, err: types.ErrOperatorNotAllowed},
+		{name: "allowed in group proposal", msgs: []sdk.Msg{groupProposal(t, msgCreateValidator(listed))}},
+		{name: "in group proposal", msgs: []sdk.Msg{groupProposal(t, msgCreateValidator(other))}, err: types.ErrOperatorNotAllowed},
+		{name: "in authz exec in group proposal", msgs: []sdk.Msg{groupProposal(t, authzExec(msgCreateValidator(other)))}, err: types.ErrOperatorNotAllowed},
+		{name: "in group proposal in authz exec", msgs: []sdk.Msg{authzExec(groupProposal(t, msgCreateValidator(other)))}, err: types.ErrOperatorNotAllowed},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			called := false
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				called = true
+				return ctx, nil
+			}
+
+			_, err := ante.NewCreateValidatorDecorator(*k).AnteHandle(ctx, mockTx(tc.msgs), false, next)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.False(t, called)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, called)
+		})
+	}
+}
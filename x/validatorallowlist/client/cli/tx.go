@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+)
+
+const (
+	FlagAllow    = "allow"
+	FlagDisallow = "disallow"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdUpdateOperatorAllowlist(),
+	)
+
+	return cmd
+}
+
+func CmdUpdateOperatorAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-operator-allowlist",
+		Short: "Allow and disallow validator operators, signed by an admin",
+		Example: fmt.Sprintf(
+			"$ %s tx validatorallowlist update-operator-allowlist --allow cudosvaloper1... --disallow cudosvaloper1... --from admin",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			allow, disallow, err := readUpdateFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateOperatorAllowlist(clientCtx.GetFromAddress(), allow, disallow)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUpdateFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addUpdateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagAllow, []string{}, "comma separated operator addresses to allow")
+	cmd.Flags().StringSlice(FlagDisallow, []string{}, "comma separated operator addresses to disallow")
+}
+
+func readUpdateFlags(cmd *cobra.Command) ([]string, []string, error) {
+	allow, err := cmd.Flags().GetStringSlice(FlagAllow)
+	if err != nil {
+		return nil, nil, err
+	}
+	disallow, err := cmd.Flags().GetStringSlice(FlagDisallow)
+	if err != nil {
+		return nil, nil, err
+	}
+	return allow, disallow, nil
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/client/cli"
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// UpdateOperatorAllowlistProposalHandler is the operator allowlist update proposal handler.
+var UpdateOperatorAllowlistProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateOperatorAllowlistProposal, rest.UpdateOperatorAllowlistProposalRESTHandler)
@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+)
+
+// UpdateOperatorAllowlistProposalReq defines an operator allowlist update
+// proposal request body.
+type UpdateOperatorAllowlistProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Allow       []string       `json:"allow" yaml:"allow"`
+	Disallow    []string       `json:"disallow" yaml:"disallow"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// UpdateOperatorAllowlistProposalRESTHandler returns the operator allowlist
+// update proposal REST handler.
+func UpdateOperatorAllowlistProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_operator_allowlist",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UpdateOperatorAllowlistProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			content := types.NewUpdateOperatorAllowlistProposal(req.Title, req.Description, req.Allow, req.Disallow)
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package validatorallowlist
+
+import (
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/keeper"
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the validatorallowlist module's state from a
+// provided genesis state. The operators of the validators that exist already,
+// either created by genutil or running before the upgrade adding the module,
+// are allowed as well.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	for _, operator := range data.Operators {
+		operatorAddr, err := sdk.ValAddressFromBech32(operator)
+		if err != nil {
+			panic(err)
+		}
+		k.Allow(ctx, operatorAddr)
+	}
+
+	k.GrandfatherValidators(ctx)
+}
+
+// ExportGenesis returns the validatorallowlist module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	operators := []string{}
+	k.IterateOperators(ctx, func(operator sdk.ValAddress) bool {
+		operators = append(operators, operator.String())
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), operators)
+}
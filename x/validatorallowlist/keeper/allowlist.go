@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IsEnforced reports whether validators may only be created by allowed
+// operators. The allowlist is not enforced before the module's genesis is
+// initialized, which lets genutil create the genesis validators.
+func (k Keeper) IsEnforced(ctx sdk.Context) bool {
+	return k.paramSpace.Has(ctx, types.KeyEnabled) && k.GetParams(ctx).Enabled
+}
+
+// IsAllowed reports whether the operator is on the allowlist.
+func (k Keeper) IsAllowed(ctx sdk.Context, operator sdk.ValAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.OperatorKey(operator))
+}
+
+// Allow adds the operator to the allowlist.
+func (k Keeper) Allow(ctx sdk.Context, operator sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Set(types.OperatorKey(operator), []byte{})
+}
+
+// Disallow removes the operator from the allowlist. Validators the operator
+// already runs are not affected.
+func (k Keeper) Disallow(ctx sdk.Context, operator sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.OperatorKey(operator))
+}
+
+// IterateOperators calls cb with every allowed operator until it returns true.
+func (k Keeper) IterateOperators(ctx sdk.Context, cb func(operator sdk.ValAddress) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.OperatorKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.SplitOperatorKey(iterator.Key()[len(types.OperatorKeyPrefix):])) {
+			break
+		}
+	}
+}
+
+// UpdateAllowlist allows and disallows the given operators, emitting an event
+// for each of them.
+func (k Keeper) UpdateAllowlist(ctx sdk.Context, allow, disallow []string) error {
+	if err := types.ValidateUpdate(allow, disallow); err != nil {
+		return err
+	}
+
+	for _, operator := range allow {
+		k.Allow(ctx, mustValAddress(operator))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeAllowOperator,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator),
+		))
+	}
+
+	for _, operator := range disallow {
+		k.Disallow(ctx, mustValAddress(operator))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeDisallowOperator,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator),
+		))
+	}
+
+	return nil
+}
+
+// GrandfatherValidators allows the operators of all existing validators.
+func (k Keeper) GrandfatherValidators(ctx sdk.Context) {
+	k.stakingKeeper.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		k.Allow(ctx, validator.GetOperator())
+		return false
+	})
+}
+
+// CanCreateValidator returns an error unless the operator may create a
+// validator right now.
+func (k Keeper) CanCreateValidator(ctx sdk.Context, operator sdk.ValAddress) error {
+	if !k.IsEnforced(ctx) || k.IsAllowed(ctx, operator) {
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrOperatorNotAllowed, "operator '%s' is not on the allowlist", operator)
+}
+
+// CheckCreateValidators applies the allowlist to every MsgCreateValidator in
+// msgs, including the ones nested in authz executions and group proposals.
+func (k Keeper) CheckCreateValidators(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		var nested []sdk.Msg
+		var err error
+
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgCreateValidator:
+			operator, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+			if err != nil {
+				return err
+			}
+			if err := k.CanCreateValidator(ctx, operator); err != nil {
+				return err
+			}
+			continue
+		case *authz.MsgExec:
+			nested, err = msg.GetMessages()
+		case *group.MsgSubmitProposal:
+			nested, err = msg.GetMsgs()
+		default:
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+		if err := k.CheckCreateValidators(ctx, nested); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func mustValAddress(operator string) sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(operator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) AllowedOperator(goCtx context.Context, req *types.QueryAllowedOperatorRequest) (*types.QueryAllowedOperatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.ValAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid operator address %s", req.Operator)
+	}
+
+	return &types.QueryAllowedOperatorResponse{Allowed: k.CanCreateValidator(ctx, operator) == nil}, nil
+}
+
+func (k Keeper) AllowedOperators(goCtx context.Context, req *types.QueryAllowedOperatorsRequest) (*types.QueryAllowedOperatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operators := []string{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OperatorKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		operators = append(operators, types.SplitOperatorKey(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowedOperatorsResponse{Operators: operators, Pagination: pageRes}, nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/keeper"
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	admin   = sdk.AccAddress([]byte("admin_______________"))
+	alice   = sdk.AccAddress([]byte("alice_______________"))
+	running = sdk.ValAddress([]byte("running_____________"))
+	listed  = sdk.ValAddress([]byte("listed______________"))
+	other   = sdk.ValAddress([]byte("other_______________"))
+)
+
+type mockAdminKeeper struct{}
+
+func (mockAdminKeeper) IsAdmin(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return addr.Equals(admin)
+}
+
+type mockStakingKeeper struct {
+	validators []stakingtypes.Validator
+}
+
+func (sk mockStakingKeeper) IterateValidators(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+	for i, validator := range sk.validators {
+		if fn(int64(i), validator) {
+			return
+		}
+	}
+}
+
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	stores := keepertest.NewStores()
+	storeKey := stores.KVStoreKey(types.StoreKey)
+	subspace := stores.Subspace(types.ModuleName)
+
+	sk := mockStakingKeeper{validators: []stakingtypes.Validator{{OperatorAddress: running.String()}}}
+	k := keeper.NewKeeper(stores.Codec, storeKey, subspace, mockAdminKeeper{}, sk)
+
+	return *k, stores.Context(t, tmproto.Header{})
+}
+
+func msgCreateValidator(operator sdk.ValAddress) *stakingtypes.MsgCreateValidator {
+	return &stakingtypes.MsgCreateValidator{ValidatorAddress: operator.String()}
+}
+
+func TestAllowlistBeforeGenesis(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	// genutil creates the genesis validators before the allowlist is initialized
+	require.False(t, k.IsEnforced(ctx))
+	require.NoError(t, k.CanCreateValidator(ctx, other))
+	require.NotPanics(t, func() { k.Hooks().AfterValidatorCreated(ctx, other) })
+
+	k.SetParams(ctx, types.DefaultParams())
+	k.GrandfatherValidators(ctx)
+
+	require.True(t, k.IsEnforced(ctx))
+	require.NoError(t, k.CanCreateValidator(ctx, running))
+	require.ErrorIs(t, k.CanCreateValidator(ctx, other), types.ErrOperatorNotAllowed)
+}
+
+func TestCheckCreateValidators(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetParams(ctx, types.DefaultParams())
+	k.Allow(ctx, listed)
+
+	require.NoError(t, k.CheckCreateValidators(ctx, []sdk.Msg{msgCreateValidator(listed)}))
+	require.ErrorIs(t, k.CheckCreateValidators(ctx, []sdk.Msg{msgCreateValidator(other)}), types.ErrOperatorNotAllowed)
+
+	// nested in an authz execution
+	exec := authz.NewMsgExec(alice, []sdk.Msg{msgCreateValidator(other)})
+	require.ErrorIs(t, k.CheckCreateValidators(ctx, []sdk.Msg{&exec}), types.ErrOperatorNotAllowed)
+
+	require.Panics(t, func() { k.Hooks().AfterValidatorCreated(ctx, other) })
+	require.NotPanics(t, func() { k.Hooks().AfterValidatorCreated(ctx, listed) })
+
+	// a disabled allowlist accepts every operator
+	k.SetParams(ctx, types.NewParams(false))
+	require.NoError(t, k.CheckCreateValidators(ctx, []sdk.Msg{msgCreateValidator(other)}))
+}
+
+func TestUpdateOperatorAllowlist(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetParams(ctx, types.DefaultParams())
+	k.Allow(ctx, listed)
+	srv := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// only admins update the allowlist
+	_, err := srv.UpdateOperatorAllowlist(goCtx, types.NewMsgUpdateOperatorAllowlist(alice, []string{other.String()}, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.UpdateOperatorAllowlist(goCtx, types.NewMsgUpdateOperatorAllowlist(admin, []string{other.String()}, []string{listed.String()}))
+	require.NoError(t, err)
+	require.True(t, k.IsAllowed(ctx, other))
+	require.False(t, k.IsAllowed(ctx, listed))
+
+	res, err := k.AllowedOperators(goCtx, &types.QueryAllowedOperatorsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{other.String()}, res.Operators)
+
+	allowed, err := k.AllowedOperator(goCtx, &types.QueryAllowedOperatorRequest{Operator: listed.String()})
+	require.NoError(t, err)
+	require.False(t, allowed.Allowed)
+
+	// an operator cannot be allowed and disallowed at once
+	_, err = srv.UpdateOperatorAllowlist(goCtx, types.NewMsgUpdateOperatorAllowlist(admin, []string{other.String()}, []string{other.String()}))
+	require.ErrorIs(t, err, types.ErrInvalidUpdate)
+}
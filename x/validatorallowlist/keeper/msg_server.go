@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/CudoVentures/cudos-node/x/validatorallowlist/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (m msgServer) UpdateOperatorAllowlist(goCtx context.Context, msg *types.MsgUpdateOperatorAllowlist) (*types.MsgUpdateOperatorAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.adminKeeper.IsAdmin(ctx, admin) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address '%s' is not an admin", msg.Admin)
+	}
+
+	if err := m.Keeper.UpdateAllowlist(ctx, msg.Allow, msg.Disallow); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Admin),
+	))
+
+	return &types.MsgUpdateOperatorAllowlistResponse{}, nil
+}
@@ -0,0 +1,629 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cudos/validatorallowlist/allowlist.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the validatorallowlist module.
+type Params struct {
+	// enabled rejects validators created by operators that are not allowed.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58baaa67766ece87, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// UpdateOperatorAllowlistProposal allows and disallows validator operators
+// through governance.
+type UpdateOperatorAllowlistProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// allow lists the operator addresses to allow.
+	Allow []string `protobuf:"bytes,3,rep,name=allow,proto3" json:"allow,omitempty"`
+	// disallow lists the operator addresses to remove from the allowlist.
+	Disallow []string `protobuf:"bytes,4,rep,name=disallow,proto3" json:"disallow,omitempty"`
+}
+
+func (m *UpdateOperatorAllowlistProposal) Reset()      { *m = UpdateOperatorAllowlistProposal{} }
+func (*UpdateOperatorAllowlistProposal) ProtoMessage() {}
+func (*UpdateOperatorAllowlistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58baaa67766ece87, []int{1}
+}
+func (m *UpdateOperatorAllowlistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateOperatorAllowlistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateOperatorAllowlistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateOperatorAllowlistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateOperatorAllowlistProposal.Merge(m, src)
+}
+func (m *UpdateOperatorAllowlistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateOperatorAllowlistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateOperatorAllowlistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateOperatorAllowlistProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cudos.validatorallowlist.Params")
+	proto.RegisterType((*UpdateOperatorAllowlistProposal)(nil), "cudos.validatorallowlist.UpdateOperatorAllowlistProposal")
+}
+
+func init() {
+	proto.RegisterFile("cudos/validatorallowlist/allowlist.proto", fileDescriptor_58baaa67766ece87)
+}
+
+var fileDescriptor_58baaa67766ece87 = []byte{
+	// 282 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
+	0x18, 0xc4, 0x6d, 0x0a, 0xa5, 0x18, 0xa6, 0xa8, 0x43, 0xd4, 0xc1, 0xa9, 0x3a, 0x75, 0x21, 0x1e,
+	0xd8, 0x90, 0x18, 0x80, 0x07, 0xa0, 0xaa, 0x04, 0x48, 0x6c, 0x4e, 0x6c, 0x05, 0x4b, 0x6e, 0x3e,
+	0xcb, 0x76, 0xf8, 0xf3, 0x06, 0x8c, 0x8c, 0xb0, 0xe5, 0x71, 0x18, 0x3b, 0x32, 0xa2, 0x64, 0xe1,
+	0x31, 0x50, 0x1c, 0xb5, 0x42, 0x82, 0xed, 0x3b, 0xdf, 0xef, 0x4e, 0xd6, 0x91, 0x79, 0x5e, 0x09,
+	0x70, 0xec, 0x81, 0x6b, 0x25, 0xb8, 0x07, 0xcb, 0xb5, 0x86, 0x47, 0xad, 0x9c, 0x67, 0xdb, 0x2b,
+	0x35, 0x16, 0x3c, 0x44, 0x71, 0x20, 0xd3, 0xbf, 0xe4, 0x64, 0x5c, 0x40, 0x01, 0x01, 0x62, 0xdd,
+	0xd5, 0xf3, 0xb3, 0x19, 0x19, 0x2e, 0xb8, 0xe5, 0x2b, 0x17, 0xc5, 0x64, 0x5f, 0x96, 0x3c, 0xd3,
+	0x52, 0xc4, 0x78, 0x8a, 0xe7, 0xa3, 0xe5, 0x46, 0xce, 0xde, 0x31, 0x49, 0xae, 0x8d, 0xe0, 0x5e,
+	0x5e, 0x19, 0x69, 0xbb, 0xd6, 0xf3, 0x4d, 0xeb, 0xc2, 0x82, 0x01, 0xc7, 0x75, 0x34, 0x26, 0x7b,
+	0x5e, 0x79, 0x2d, 0x43, 0xf6, 0x60, 0xd9, 0x8b, 0x68, 0x4a, 0x0e, 0x85, 0x74, 0xb9, 0x55, 0xc6,
+	0x2b, 0x28, 0xe3, 0x9d, 0xe0, 0xfd, 0x7e, 0xea, 0x72, 0xe1, 0x8b, 0xf1, 0x60, 0x3a, 0xe8, 0x72,
+	0x41, 0x44, 0x13, 0x32, 0x12, 0xca, 0xf5, 0xc6, 0x6e, 0x30, 0xb6, 0xfa, 0xf4, 0xe8, 0xa5, 0x4e,
+	0xd0, 0x5b, 0x9d, 0xa0, 0xef, 0x3a, 0x41, 0x17, 0xb7, 0x1f, 0x0d, 0xc5, 0xeb, 0x86, 0xe2, 0xaf,
+	0x86, 0xe2, 0xd7, 0x96, 0xa2, 0x75, 0x4b, 0xd1, 0x67, 0x4b, 0xd1, 0xdd, 0x59, 0xa1, 0xfc, 0x7d,
+	0x95, 0xa5, 0x39, 0xac, 0xd8, 0x65, 0x25, 0xe0, 0x46, 0x96, 0xbe, 0xb2, 0xd2, 0xb1, 0xb0, 0xd0,
+	0x71, 0x09, 0x42, 0xb2, 0xa7, 0xff, 0x26, 0xf5, 0xcf, 0x46, 0xba, 0x6c, 0x18, 0xf6, 0x39, 0xf9,
+	0x19, 0x00, 0x72, 0xe3, 0xcc, 0xa1, 0x7b, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateOperatorAllowlistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateOperatorAllowlistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateOperatorAllowlistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Disallow) > 0 {
+		for iNdEx := len(m.Disallow) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Disallow[iNdEx])
+			copy(dAtA[i:], m.Disallow[iNdEx])
+			i = encodeVarintAllowlist(dAtA, i, uint64(len(m.Disallow[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Allow) > 0 {
+		for iNdEx := len(m.Allow) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allow[iNdEx])
+			copy(dAtA[i:], m.Allow[iNdEx])
+			i = encodeVarintAllowlist(dAtA, i, uint64(len(m.Allow[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintAllowlist(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintAllowlist(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAllowlist(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAllowlist(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateOperatorAllowlistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovAllowlist(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovAllowlist(uint64(l))
+	}
+	if len(m.Allow) > 0 {
+		for _, s := range m.Allow {
+			l = len(s)
+			n += 1 + l + sovAllowlist(uint64(l))
+		}
+	}
+	if len(m.Disallow) > 0 {
+		for _, s := range m.Disallow {
+			l = len(s)
+			n += 1 + l + sovAllowlist(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAllowlist(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAllowlist(x uint64) (n int) {
+	return sovAllowlist(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllowlist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllowlist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateOperatorAllowlistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllowlist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateOperatorAllowlistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateOperatorAllowlistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allow = append(m.Allow, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disallow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disallow = append(m.Disallow, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllowlist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAllowlist(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAllowlist
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAllowlist
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAllowlist
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAllowlist
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAllowlist        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAllowlist          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAllowlist = fmt.Errorf("proto: unexpected end of group")
+)
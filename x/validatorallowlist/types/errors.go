@@ -0,0 +1,14 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/validatorallowlist module sentinel errors
+var (
+	ErrOperatorNotAllowed = sdkerrors.Register(ModuleName, 1100, "operator is not allowed to create a validator")
+	ErrInvalidUpdate      = sdkerrors.Register(ModuleName, 1101, "invalid allowlist update")
+	ErrInvalidGenesis     = sdkerrors.Register(ModuleName, 1102, "invalid genesis")
+)
@@ -0,0 +1,9 @@
+package types
+
+// validatorallowlist module event types
+const (
+	EventTypeAllowOperator    = "allow_operator"
+	EventTypeDisallowOperator = "disallow_operator"
+
+	AttributeKeyOperator = "operator"
+)
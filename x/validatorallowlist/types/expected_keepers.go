@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// AdminKeeper defines the expected admin keeper, which decides who may
+// update the allowlist without governance.
+type AdminKeeper interface {
+	IsAdmin(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
+// StakingKeeper defines the expected staking keeper, used to grandfather the
+// existing validators.
+type StakingKeeper interface {
+	IterateValidators(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool))
+}
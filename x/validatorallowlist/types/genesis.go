@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, operators []string) *GenesisState {
+	return &GenesisState{Params: params, Operators: operators}
+}
+
+// DefaultGenesis returns the default validatorallowlist genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []string{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Operators))
+	for _, operator := range gs.Operators {
+		if _, err := sdk.ValAddressFromBech32(operator); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "invalid operator address %s: %s", operator, err)
+		}
+		if seen[operator] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate operator %s", operator)
+		}
+		seen[operator] = true
+	}
+	return nil
+}
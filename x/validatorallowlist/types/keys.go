@@ -0,0 +1,34 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "validatorallowlist"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for validatorallowlist
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// OperatorKeyPrefix prefixes the allowed operators, an empty value stored
+// under OperatorKeyPrefix | len(operator) | operator.
+var OperatorKeyPrefix = []byte{0x01}
+
+// OperatorKey returns the key of an allowed operator.
+func OperatorKey(operator sdk.ValAddress) []byte {
+	key := append([]byte{}, OperatorKeyPrefix...)
+	key = append(key, byte(len(operator)))
+	return append(key, operator...)
+}
+
+// SplitOperatorKey returns the operator of a key with its OperatorKeyPrefix
+// removed.
+func SplitOperatorKey(key []byte) sdk.ValAddress {
+	return sdk.ValAddress(key[1 : 1+int(key[0])])
+}
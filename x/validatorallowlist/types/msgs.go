@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateOperatorAllowlist = "update_operator_allowlist"
+
+var _ sdk.Msg = &MsgUpdateOperatorAllowlist{}
+
+func NewMsgUpdateOperatorAllowlist(admin sdk.AccAddress, allow, disallow []string) *MsgUpdateOperatorAllowlist {
+	return &MsgUpdateOperatorAllowlist{Admin: admin.String(), Allow: allow, Disallow: disallow}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateOperatorAllowlist) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateOperatorAllowlist) Type() string { return TypeMsgUpdateOperatorAllowlist }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateOperatorAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid admin address (%s)", err)
+	}
+	return ValidateUpdate(msg.Allow, msg.Disallow)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateOperatorAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateOperatorAllowlist) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateUpdate checks that an update changes at least one operator and
+// that every operator is a valid address listed only once.
+func ValidateUpdate(allow, disallow []string) error {
+	if len(allow) == 0 && len(disallow) == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpdate, "no operators to allow or disallow")
+	}
+
+	seen := make(map[string]bool, len(allow)+len(disallow))
+	for _, operator := range append(append([]string{}, allow...), disallow...) {
+		if _, err := sdk.ValAddressFromBech32(operator); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address %s (%s)", operator, err)
+		}
+		if seen[operator] {
+			return sdkerrors.Wrapf(ErrInvalidUpdate, "operator %s is listed more than once", operator)
+		}
+		seen[operator] = true
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyEnabled = []byte("Enabled")
+)
+
+// ParamKeyTable ParamTable for validatorallowlist module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(enabled bool) Params {
+	return Params{
+		Enabled: enabled,
+	}
+}
+
+// DefaultParams enforces the allowlist.
+func DefaultParams() Params {
+	return NewParams(true)
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	return validateEnabled(p.Enabled)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
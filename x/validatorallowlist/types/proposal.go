@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateOperatorAllowlist defines the type for an UpdateOperatorAllowlistProposal
+	ProposalTypeUpdateOperatorAllowlist = "UpdateOperatorAllowlist"
+)
+
+var _ govtypes.Content = &UpdateOperatorAllowlistProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateOperatorAllowlist)
+	govtypes.RegisterProposalTypeCodec(&UpdateOperatorAllowlistProposal{}, "validatorallowlist/UpdateOperatorAllowlistProposal")
+}
+
+// NewUpdateOperatorAllowlistProposal creates a new operator allowlist update proposal.
+func NewUpdateOperatorAllowlistProposal(title, description string, allow, disallow []string) *UpdateOperatorAllowlistProposal {
+	return &UpdateOperatorAllowlistProposal{
+		Title:       title,
+		Description: description,
+		Allow:       allow,
+		Disallow:    disallow,
+	}
+}
+
+// GetTitle returns the title of an operator allowlist update proposal.
+func (p *UpdateOperatorAllowlistProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an operator allowlist update proposal.
+func (p *UpdateOperatorAllowlistProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an operator allowlist update proposal.
+func (p *UpdateOperatorAllowlistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an operator allowlist update proposal.
+func (p *UpdateOperatorAllowlistProposal) ProposalType() string {
+	return ProposalTypeUpdateOperatorAllowlist
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateOperatorAllowlistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return ValidateUpdate(p.Allow, p.Disallow)
+}
+
+// String implements the Stringer interface.
+func (p UpdateOperatorAllowlistProposal) String() string {
+	return fmt.Sprintf(`Update Operator Allowlist Proposal:
+  Title:       %s
+  Description: %s
+  Allow:       %s
+  Disallow:    %s
+`, p.Title, p.Description, strings.Join(p.Allow, ", "), strings.Join(p.Disallow, ", "))
+}
@@ -0,0 +1,25 @@
+package ante
+
+import (
+	"github.com/CudoVentures/cudos-node/x/wasmgov/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UploadDecorator rejects transactions storing wasm code the upload policy
+// does not allow. Store code proposals are executed by gov and are not
+// affected.
+type UploadDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewUploadDecorator(k keeper.Keeper) UploadDecorator {
+	return UploadDecorator{keeper: k}
+}
+
+func (d UploadDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.keeper.CheckUploads(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
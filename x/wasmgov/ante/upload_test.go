@@ -0,0 +1,67 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/ante"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/keeper"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	uploader = sdk.AccAddress([]byte("uploader____________"))
+	other    = sdk.AccAddress([]byte("other_______________"))
+)
+
+type mockTx []sdk.Msg
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func storeCode(sender sdk.AccAddress) *wasmtypes.MsgStoreCode {
+	return &wasmtypes.MsgStoreCode{Sender: sender.String(), WASMByteCode: []byte("code")}
+}
+
+func TestUploadDecorator(t *testing.T) {
+	stores := keepertest.NewStores()
+	k := keeper.NewKeeper(stores.Subspace(types.ModuleName), stores.Subspace(wasmtypes.ModuleName))
+	ctx := stores.Context(t, tmproto.Header{})
+	k.SetParams(ctx, types.NewParams([]string{}, types.UploadAccessAllowlist, []string{uploader.String()}))
+
+	exec := authz.NewMsgExec(uploader, []sdk.Msg{storeCode(other)})
+	for _, tc := range []struct {
+		name string
+		msgs []sdk.Msg
+		err  bool
+	}{
+		{name: "allowed uploader", msgs: []sdk.Msg{storeCode(uploader)}},
+		{name: "uploader not allowed", msgs: []sdk.Msg{storeCode(other)}, err: true},
+		{name: "after an allowed upload", msgs: []sdk.Msg{storeCode(uploader), storeCode(other)}, err: true},
+		{name: "nested in authz exec", msgs: []sdk.Msg{&exec}, err: true},
+		{name: "other messages", msgs: []sdk.Msg{&wasmtypes.MsgExecuteContract{Sender: other.String()}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			called := false
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				called = true
+				return ctx, nil
+			}
+
+			_, err := ante.NewUploadDecorator(k).AnteHandle(ctx, mockTx(tc.msgs), false, next)
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrUploadDenied)
+				require.False(t, called)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, called)
+		})
+	}
+}
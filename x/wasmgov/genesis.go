@@ -0,0 +1,22 @@
+package wasmgov
+
+import (
+	"github.com/CudoVentures/cudos-node/x/wasmgov/keeper"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the wasmgov module's state from a provided genesis
+// state. The wasm module has to be initialized first.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	if err := k.CheckUploadPolicies(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the wasmgov module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+type (
+	Keeper struct {
+		paramSpace     paramtypes.Subspace
+		wasmParamSpace paramtypes.Subspace
+	}
+)
+
+// NewKeeper creates the wasmgov keeper. wasmParamSpace is the subspace of the
+// wasm module, whose CodeUploadAccess param the upload policy replaces.
+func NewKeeper(paramSpace, wasmParamSpace paramtypes.Subspace) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	if !wasmParamSpace.HasKeyTable() {
+		wasmParamSpace = wasmParamSpace.WithKeyTable(wasmtypes.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace:     paramSpace,
+		wasmParamSpace: wasmParamSpace,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of wasmgov parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of wasmgov parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// CheckUploadPolicies returns an error when both the upload policy and the
+// CodeUploadAccess param of the wasm module restrict who may store code.
+// Only one of them may be in use, so that a single param decides who uploads.
+func (k Keeper) CheckUploadPolicies(ctx sdk.Context) error {
+	var wasmUploadAccess wasmtypes.AccessConfig
+	k.wasmParamSpace.Get(ctx, wasmtypes.ParamStoreKeyUploadAccess, &wasmUploadAccess)
+
+	uploadAccess := k.GetParams(ctx).UploadAccess
+	if uploadAccess != types.UploadAccessPermissionless && !wasmUploadAccess.Equals(wasmtypes.AllowEverybody) {
+		return sdkerrors.Wrapf(types.ErrUploadConflict, "the wasm code upload access %s must allow everybody while the upload access is %s", wasmUploadAccess.Permission, uploadAccess)
+	}
+	return nil
+}
+
+// CanUpload returns an error unless the sender may store code right now.
+func (k Keeper) CanUpload(ctx sdk.Context, sender string) error {
+	params := k.GetParams(ctx)
+	if params.CanUpload(sender) {
+		return nil
+	}
+
+	if params.UploadAccess == types.UploadAccessGovernance {
+		return sdkerrors.Wrap(types.ErrUploadDenied, "code can only be stored through governance")
+	}
+	return sdkerrors.Wrapf(types.ErrUploadDenied, "address '%s' is not an allowed uploader", sender)
+}
+
+// CheckUploads applies the upload policy to every MsgStoreCode in msgs,
+// including the ones nested in authz executions and group proposals.
+func (k Keeper) CheckUploads(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		var nested []sdk.Msg
+		var err error
+
+		switch msg := msg.(type) {
+		case *wasmtypes.MsgStoreCode:
+			if err := k.CanUpload(ctx, msg.Sender); err != nil {
+				return err
+			}
+			continue
+		case *authz.MsgExec:
+			nested, err = msg.GetMessages()
+		case *group.MsgSubmitProposal:
+			nested, err = msg.GetMsgs()
+		default:
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+		if err := k.CheckUploads(ctx, nested); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
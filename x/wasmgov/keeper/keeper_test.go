@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/keeper"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	uploader = sdk.AccAddress([]byte("uploader____________"))
+	other    = sdk.AccAddress([]byte("other_______________"))
+	contract = sdk.AccAddress([]byte("contract____________"))
+)
+
+func setupKeeper(t *testing.T) (keeper.Keeper, paramtypes.Subspace, *keepertest.Stores, sdk.Context) {
+	// contracts dispatch wasm and authz messages
+	registry := codectypes.NewInterfaceRegistry()
+	wasmtypes.RegisterInterfaces(registry)
+	authz.RegisterInterfaces(registry)
+	stores := keepertest.NewStores()
+	stores.Codec = codec.NewProtoCodec(registry)
+
+	wasmSubspace := stores.Subspace(wasmtypes.ModuleName).WithKeyTable(wasmtypes.ParamKeyTable())
+	k := keeper.NewKeeper(stores.Subspace(types.ModuleName), wasmSubspace)
+
+	ctx := stores.Context(t, tmproto.Header{})
+	wasmParams := wasmtypes.DefaultParams()
+	wasmSubspace.SetParamSet(ctx, &wasmParams)
+	k.SetParams(ctx, types.NewParams([]string{}, types.UploadAccessAllowlist, []string{uploader.String()}))
+
+	return k, wasmSubspace, stores, ctx
+}
+
+func storeCode(sender sdk.AccAddress) *wasmtypes.MsgStoreCode {
+	return &wasmtypes.MsgStoreCode{Sender: sender.String(), WASMByteCode: []byte("code")}
+}
+
+func TestCheckUploads(t *testing.T) {
+	k, _, _, ctx := setupKeeper(t)
+
+	require.NoError(t, k.CheckUploads(ctx, []sdk.Msg{storeCode(uploader)}))
+	require.ErrorIs(t, k.CheckUploads(ctx, []sdk.Msg{storeCode(other)}), types.ErrUploadDenied)
+
+	// nested in an authz execution and a group proposal
+	exec := authz.NewMsgExec(uploader, []sdk.Msg{storeCode(other)})
+	require.ErrorIs(t, k.CheckUploads(ctx, []sdk.Msg{&exec}), types.ErrUploadDenied)
+	proposal, err := group.NewMsgSubmitProposal(other.String(), []string{uploader.String()}, []sdk.Msg{storeCode(other)}, "", group.Exec_EXEC_UNSPECIFIED)
+	require.NoError(t, err)
+	require.ErrorIs(t, k.CheckUploads(ctx, []sdk.Msg{proposal}), types.ErrUploadDenied)
+
+	k.SetParams(ctx, types.NewParams([]string{}, types.UploadAccessGovernance, []string{uploader.String()}))
+	require.ErrorIs(t, k.CheckUploads(ctx, []sdk.Msg{storeCode(uploader)}), types.ErrUploadDenied)
+}
+
+func TestCheckUploadPolicies(t *testing.T) {
+	k, wasmSubspace, _, ctx := setupKeeper(t)
+	require.NoError(t, k.CheckUploadPolicies(ctx))
+
+	// both policies restrict uploads
+	wasmSubspace.Set(ctx, wasmtypes.ParamStoreKeyUploadAccess, wasmtypes.AccessTypeOnlyAddress.With(uploader))
+	require.ErrorIs(t, k.CheckUploadPolicies(ctx), types.ErrUploadConflict)
+
+	// only the wasm param restricts uploads
+	k.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, k.CheckUploadPolicies(ctx))
+}
+
+type mockMessenger struct {
+	dispatched []wasmvmtypes.CosmosMsg
+}
+
+func (m *mockMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	m.dispatched = append(m.dispatched, msg)
+	return nil, nil, nil
+}
+
+func stargate(t *testing.T, msg sdk.Msg) wasmvmtypes.CosmosMsg {
+	any, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	return wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{TypeURL: any.TypeUrl, Value: any.Value}}
+}
+
+func TestMessageHandlerDecorator(t *testing.T) {
+	k, _, stores, ctx := setupKeeper(t)
+	next := &mockMessenger{}
+	messenger := k.MessageHandlerDecorator(stores.Codec)(next)
+
+	exec := authz.NewMsgExec(contract, []sdk.Msg{storeCode(other)})
+	for _, tc := range []struct {
+		name string
+		msg  wasmvmtypes.CosmosMsg
+		err  error
+	}{
+		{name: "allowed uploader", msg: stargate(t, storeCode(uploader))},
+		{name: "uploader not allowed", msg: stargate(t, storeCode(other)), err: types.ErrUploadDenied},
+		{name: "nested in authz exec", msg: stargate(t, &exec), err: types.ErrUploadDenied},
+		{name: "other message", msg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: other.String()}}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			next.dispatched = nil
+			_, _, err := messenger.DispatchMsg(ctx, contract, "", tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Empty(t, next.dispatched)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []wasmvmtypes.CosmosMsg{tc.msg}, next.dispatched)
+		})
+	}
+
+	// a message the codec cannot unpack is not dispatched
+	next.dispatched = nil
+	_, _, err := messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{TypeURL: "/unknown.Msg"}})
+	require.Error(t, err)
+	require.Empty(t, next.dispatched)
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// uploadMessenger applies the upload policy to stargate messages dispatched by
+// contracts, which never pass through the ante handler.
+type uploadMessenger struct {
+	keeper Keeper
+	cdc    codec.Codec
+	next   wasmkeeper.Messenger
+}
+
+// MessageHandlerDecorator returns a wasm keeper option decorator that keeps
+// contracts from storing code the upload policy does not allow.
+func (k Keeper) MessageHandlerDecorator(cdc codec.Codec) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(next wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return uploadMessenger{keeper: k, cdc: cdc, next: next}
+	}
+}
+
+func (m uploadMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Stargate != nil {
+		any := &codectypes.Any{TypeUrl: msg.Stargate.TypeURL, Value: msg.Stargate.Value}
+
+		var sdkMsg sdk.Msg
+		if err := m.cdc.UnpackAny(any, &sdkMsg); err != nil {
+			return nil, nil, err
+		}
+		if err := m.keeper.CheckUploads(ctx, []sdk.Msg{sdkMsg}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return m.next.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
@@ -0,0 +1,41 @@
+package wasmgov
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/keeper"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewWasmProposalHandler creates the governance handler for wasm proposals.
+// Every wasm proposal type is wired in, but a proposal is only executed when
+// its type is enabled in the module params at the time it is handled, which
+// gov also does when the proposal is submitted.
+func NewWasmProposalHandler(k keeper.Keeper, wasmKeeper wasmtypes.ContractOpsKeeper) govtypes.Handler {
+	wasmHandler := wasmkeeper.NewWasmProposalHandlerX(wasmKeeper, wasmtypes.EnableAllProposals)
+
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if !k.GetParams(ctx).IsProposalEnabled(content.ProposalType()) {
+			return sdkerrors.Wrap(types.ErrProposalDisabled, content.ProposalType())
+		}
+
+		return wasmHandler(ctx, content)
+	}
+}
+
+// NewParamChangeProposalHandler wraps the params proposal handler and rejects
+// the param changes that would make the upload policy and the CodeUploadAccess
+// param of the wasm module both restrict uploads. Gov discards the changes of
+// a failed proposal.
+func NewParamChangeProposalHandler(k keeper.Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := paramsHandler(ctx, content); err != nil {
+			return err
+		}
+
+		return k.CheckUploadPolicies(ctx)
+	}
+}
@@ -0,0 +1,108 @@
+package wasmgov_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	keepertest "github.com/CudoVentures/cudos-node/testutil/keeper"
+	"github.com/CudoVentures/cudos-node/x/wasmgov"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/keeper"
+	"github.com/CudoVentures/cudos-node/x/wasmgov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+var runAs = sdk.AccAddress([]byte("run_as______________"))
+
+// mockContractOpsKeeper records the code stored and pinned by proposals, any
+// other operation panics.
+type mockContractOpsKeeper struct {
+	wasmtypes.ContractOpsKeeper
+	created, pinned []uint64
+}
+
+func (k *mockContractOpsKeeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *wasmtypes.AccessConfig) (uint64, error) {
+	codeID := uint64(len(k.created) + 1)
+	k.created = append(k.created, codeID)
+	return codeID, nil
+}
+
+func (k *mockContractOpsKeeper) PinCode(ctx sdk.Context, codeID uint64) error {
+	k.pinned = append(k.pinned, codeID)
+	return nil
+}
+
+func setupKeeper(t *testing.T) (keeper.Keeper, paramtypes.Subspace, sdk.Context) {
+	stores := keepertest.NewStores()
+	wasmSubspace := stores.Subspace(wasmtypes.ModuleName).WithKeyTable(wasmtypes.ParamKeyTable())
+	k := keeper.NewKeeper(stores.Subspace(types.ModuleName), wasmSubspace)
+
+	ctx := stores.Context(t, tmproto.Header{})
+	wasmParams := wasmtypes.DefaultParams()
+	wasmSubspace.SetParamSet(ctx, &wasmParams)
+	k.SetParams(ctx, types.DefaultParams())
+
+	return k, wasmSubspace, ctx
+}
+
+func TestWasmProposalHandler(t *testing.T) {
+	k, _, ctx := setupKeeper(t)
+	wasmKeeper := &mockContractOpsKeeper{}
+	handler := wasmgov.NewWasmProposalHandler(k, wasmKeeper)
+
+	proposal := &wasmtypes.StoreCodeProposal{Title: "Store", Description: "Store code", RunAs: runAs.String(), WASMByteCode: []byte("code")}
+	require.ErrorIs(t, handler(ctx, proposal), types.ErrProposalDisabled)
+	require.Empty(t, wasmKeeper.created)
+
+	k.SetParams(ctx, types.NewParams([]string{string(wasmtypes.ProposalTypeStoreCode)}, types.UploadAccessGovernance, []string{}))
+	require.NoError(t, handler(ctx, proposal))
+	require.Equal(t, []uint64{1}, wasmKeeper.created)
+	require.Equal(t, []uint64{1}, wasmKeeper.pinned)
+
+	// the other proposal types stay disabled
+	require.ErrorIs(t, handler(ctx, &wasmtypes.PinCodesProposal{Title: "Pin", Description: "Pin code", CodeIDs: []uint64{1}}), types.ErrProposalDisabled)
+}
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	k, wasmSubspace, ctx := setupKeeper(t)
+
+	// sets the upload policies of both modules in place of the params keeper
+	var uploadAccess types.UploadAccess
+	var wasmUploadAccess wasmtypes.AccessConfig
+	paramsHandler := func(ctx sdk.Context, content govtypes.Content) error {
+		params := k.GetParams(ctx)
+		params.UploadAccess = uploadAccess
+		k.SetParams(ctx, params)
+		wasmSubspace.Set(ctx, wasmtypes.ParamStoreKeyUploadAccess, wasmUploadAccess)
+		return nil
+	}
+	handler := wasmgov.NewParamChangeProposalHandler(k, paramsHandler)
+	proposal := paramproposal.NewParameterChangeProposal("Upload policy", "Restrict uploads", nil)
+
+	for _, tc := range []struct {
+		name             string
+		uploadAccess     types.UploadAccess
+		wasmUploadAccess wasmtypes.AccessConfig
+		err              bool
+	}{
+		{name: "permissionless", uploadAccess: types.UploadAccessPermissionless, wasmUploadAccess: wasmtypes.AllowEverybody},
+		{name: "upload policy", uploadAccess: types.UploadAccessAllowlist, wasmUploadAccess: wasmtypes.AllowEverybody},
+		{name: "wasm code upload access", uploadAccess: types.UploadAccessPermissionless, wasmUploadAccess: wasmtypes.AllowNobody},
+		{name: "both", uploadAccess: types.UploadAccessGovernance, wasmUploadAccess: wasmtypes.AccessTypeOnlyAddress.With(runAs), err: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			uploadAccess, wasmUploadAccess = tc.uploadAccess, tc.wasmUploadAccess
+			err := handler(ctx, proposal)
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrUploadConflict)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/wasmgov module sentinel errors
+var (
+	ErrProposalDisabled = sdkerrors.Register(ModuleName, 1100, "wasm proposal type is not enabled")
+	ErrUploadDenied     = sdkerrors.Register(ModuleName, 1101, "code upload is not allowed")
+	ErrUploadConflict   = sdkerrors.Register(ModuleName, 1102, "conflicting code upload policies")
+)
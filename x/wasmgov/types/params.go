@@ -0,0 +1,137 @@
+package types
+
+import (
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyEnabledProposals = []byte("EnabledProposals")
+	KeyUploadAccess     = []byte("UploadAccess")
+	KeyUploaders        = []byte("Uploaders")
+)
+
+// ParamKeyTable ParamTable for wasmgov module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(enabledProposals []string, uploadAccess UploadAccess, uploaders []string) Params {
+	return Params{
+		EnabledProposals: enabledProposals,
+		UploadAccess:     uploadAccess,
+		Uploaders:        uploaders,
+	}
+}
+
+// DefaultParams disables all wasm proposals and lets anyone upload code,
+// which is how the chain behaved before the policy became a parameter.
+func DefaultParams() Params {
+	return Params{
+		EnabledProposals: []string{},
+		UploadAccess:     UploadAccessPermissionless,
+		Uploaders:        []string{},
+	}
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	if err := validateEnabledProposals(p.EnabledProposals); err != nil {
+		return err
+	}
+	if err := validateUploadAccess(p.UploadAccess); err != nil {
+		return err
+	}
+
+	return validateUploaders(p.Uploaders)
+}
+
+// IsProposalEnabled reports whether governance may execute wasm proposals of the given type.
+func (p Params) IsProposalEnabled(proposalType string) bool {
+	for _, enabled := range p.EnabledProposals {
+		if enabled == proposalType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanUpload reports whether the given address may store code with MsgStoreCode.
+func (p Params) CanUpload(addr string) bool {
+	switch p.UploadAccess {
+	case UploadAccessPermissionless:
+		return true
+	case UploadAccessAllowlist:
+		for _, uploader := range p.Uploaders {
+			if uploader == addr {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabledProposals, &p.EnabledProposals, validateEnabledProposals),
+		paramtypes.NewParamSetPair(KeyUploadAccess, &p.UploadAccess, validateUploadAccess),
+		paramtypes.NewParamSetPair(KeyUploaders, &p.Uploaders, validateUploaders),
+	}
+}
+
+func validateEnabledProposals(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, proposalType := range v {
+		if seen[proposalType] {
+			return fmt.Errorf("duplicate proposal type: %s", proposalType)
+		}
+		seen[proposalType] = true
+	}
+
+	_, err := wasmtypes.ConvertToProposals(v)
+	return err
+}
+
+func validateUploadAccess(i interface{}) error {
+	v, ok := i.(UploadAccess)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := UploadAccess_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown upload access: %d", v)
+	}
+	return nil
+}
+
+func validateUploaders(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, uploader := range v {
+		if _, err := sdk.AccAddressFromBech32(uploader); err != nil {
+			return fmt.Errorf("invalid uploader address %s: %w", uploader, err)
+		}
+		if seen[uploader] {
+			return fmt.Errorf("duplicate uploader: %s", uploader)
+		}
+		seen[uploader] = true
+	}
+
+	return nil
+}
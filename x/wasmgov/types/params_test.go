@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanUpload(t *testing.T) {
+	uploader := sdk.AccAddress("uploader____________").String()
+	other := sdk.AccAddress("other_______________").String()
+
+	params := NewParams([]string{}, UploadAccessPermissionless, []string{uploader})
+	require.True(t, params.CanUpload(other))
+
+	params.UploadAccess = UploadAccessAllowlist
+	require.True(t, params.CanUpload(uploader))
+	require.False(t, params.CanUpload(other))
+
+	params.UploadAccess = UploadAccessGovernance
+	require.False(t, params.CanUpload(uploader))
+}
+
+func TestValidateParams(t *testing.T) {
+	params := NewParams([]string{string(wasmtypes.ProposalTypeStoreCode)}, UploadAccessGovernance, []string{})
+	require.NoError(t, params.Validate())
+	require.True(t, params.IsProposalEnabled(string(wasmtypes.ProposalTypeStoreCode)))
+	require.False(t, params.IsProposalEnabled(string(wasmtypes.ProposalTypeMigrateContract)))
+
+	params.EnabledProposals = []string{"Unknown"}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.UploadAccess = UploadAccess(5)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.Uploaders = []string{"invalid"}
+	require.Error(t, params.Validate())
+}
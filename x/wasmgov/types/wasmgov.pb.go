@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cudos/wasmgov/wasmgov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UploadAccess defines who may store new wasm code with MsgStoreCode.
+type UploadAccess int32
+
+const (
+	// UPLOAD_ACCESS_PERMISSIONLESS lets any account upload code.
+	UploadAccessPermissionless UploadAccess = 0
+	// UPLOAD_ACCESS_ALLOWLIST lets only the listed uploaders upload code.
+	UploadAccessAllowlist UploadAccess = 1
+	// UPLOAD_ACCESS_GOVERNANCE only accepts code through a store code proposal.
+	UploadAccessGovernance UploadAccess = 2
+)
+
+var UploadAccess_name = map[int32]string{
+	0: "UPLOAD_ACCESS_PERMISSIONLESS",
+	1: "UPLOAD_ACCESS_ALLOWLIST",
+	2: "UPLOAD_ACCESS_GOVERNANCE",
+}
+
+var UploadAccess_value = map[string]int32{
+	"UPLOAD_ACCESS_PERMISSIONLESS": 0,
+	"UPLOAD_ACCESS_ALLOWLIST":      1,
+	"UPLOAD_ACCESS_GOVERNANCE":     2,
+}
+
+func (x UploadAccess) String() string {
+	return proto.EnumName(UploadAccess_name, int32(x))
+}
+
+func (UploadAccess) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4478c8869034e5cb, []int{0}
+}
+
+// Params defines the wasm governance policy of the chain.
+type Params struct {
+	// enabled_proposals lists the wasm proposal types governance may execute.
+	EnabledProposals []string `protobuf:"bytes,1,rep,name=enabled_proposals,json=enabledProposals,proto3" json:"enabled_proposals,omitempty" yaml:"enabled_proposals"`
+	// upload_access restricts who may store code in place of the
+	// code_upload_access param of the wasm module, which has to allow everybody
+	// unless upload_access is UPLOAD_ACCESS_PERMISSIONLESS.
+	UploadAccess UploadAccess `protobuf:"varint,2,opt,name=upload_access,json=uploadAccess,proto3,enum=cudos.wasmgov.UploadAccess" json:"upload_access,omitempty" yaml:"upload_access"`
+	// uploaders is consulted when upload_access is UPLOAD_ACCESS_ALLOWLIST.
+	Uploaders []string `protobuf:"bytes,3,rep,name=uploaders,proto3" json:"uploaders,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4478c8869034e5cb, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabledProposals() []string {
+	if m != nil {
+		return m.EnabledProposals
+	}
+	return nil
+}
+
+func (m *Params) GetUploadAccess() UploadAccess {
+	if m != nil {
+		return m.UploadAccess
+	}
+	return UploadAccessPermissionless
+}
+
+func (m *Params) GetUploaders() []string {
+	if m != nil {
+		return m.Uploaders
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cudos.wasmgov.UploadAccess", UploadAccess_name, UploadAccess_value)
+	proto.RegisterType((*Params)(nil), "cudos.wasmgov.Params")
+}
+
+func init() { proto.RegisterFile("cudos/wasmgov/wasmgov.proto", fileDescriptor_4478c8869034e5cb) }
+
+var fileDescriptor_4478c8869034e5cb = []byte{
+	// 407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x6a, 0x9c, 0x40,
+	0x18, 0xc7, 0x9d, 0xa4, 0x04, 0x32, 0x24, 0xc5, 0x4a, 0xda, 0x5a, 0xb3, 0x4c, 0xc4, 0x53, 0x28,
+	0x54, 0x21, 0x85, 0x52, 0x7a, 0xaa, 0x6b, 0x25, 0x08, 0x76, 0x15, 0x6d, 0x52, 0xc8, 0x45, 0x66,
+	0x75, 0xb0, 0xc2, 0xe8, 0x88, 0xa3, 0x49, 0xf3, 0x06, 0x65, 0x4f, 0x7d, 0x81, 0x3d, 0xf5, 0x65,
+	0x72, 0x29, 0xec, 0xb1, 0xa7, 0xa5, 0xec, 0xbe, 0xc1, 0x3e, 0x41, 0x59, 0x77, 0xb7, 0x55, 0x7a,
+	0x9a, 0x99, 0xff, 0xf7, 0xff, 0xfd, 0xe7, 0x63, 0xe6, 0x83, 0xa7, 0x71, 0x93, 0x30, 0x6e, 0xdc,
+	0x61, 0x9e, 0xa7, 0xec, 0x76, 0xb7, 0xea, 0x65, 0xc5, 0x6a, 0x26, 0x1d, 0xb7, 0x45, 0x7d, 0x2b,
+	0x2a, 0x27, 0x29, 0x4b, 0x59, 0x5b, 0x31, 0xd6, 0xbb, 0x8d, 0x49, 0xfb, 0x09, 0xe0, 0x81, 0x8f,
+	0x2b, 0x9c, 0x73, 0xc9, 0x81, 0x4f, 0x48, 0x81, 0xc7, 0x94, 0x24, 0x51, 0x59, 0xb1, 0x92, 0x71,
+	0x4c, 0xb9, 0x0c, 0xd4, 0xfd, 0xf3, 0xc3, 0xe1, 0x60, 0x35, 0x3f, 0x93, 0xef, 0x71, 0x4e, 0xdf,
+	0x69, 0xff, 0x59, 0xb4, 0x40, 0xdc, 0x6a, 0xfe, 0x4e, 0x92, 0x6e, 0xe0, 0x71, 0x53, 0x52, 0x86,
+	0x93, 0x08, 0xc7, 0x31, 0xe1, 0x5c, 0xde, 0x53, 0xc1, 0xf9, 0xe3, 0x8b, 0x53, 0xbd, 0xd7, 0x92,
+	0x7e, 0xd5, 0x7a, 0xcc, 0xd6, 0x32, 0x94, 0x57, 0xf3, 0xb3, 0x93, 0xcd, 0x1d, 0x3d, 0x56, 0x0b,
+	0x8e, 0x9a, 0x8e, 0x4f, 0x1a, 0xc0, 0xc3, 0xcd, 0x99, 0x54, 0x5c, 0xde, 0x5f, 0xb7, 0x17, 0xfc,
+	0x13, 0x5e, 0x3e, 0x00, 0x78, 0xd4, 0x8d, 0x95, 0xde, 0xc3, 0xc1, 0x95, 0xef, 0x7a, 0xe6, 0x87,
+	0xc8, 0xb4, 0x2c, 0x3b, 0x0c, 0x23, 0xdf, 0x0e, 0x3e, 0x3a, 0x61, 0xe8, 0x78, 0x23, 0xd7, 0x0e,
+	0x43, 0x51, 0x50, 0xd0, 0x64, 0xaa, 0x2a, 0x5d, 0xc6, 0x27, 0x55, 0x9e, 0x71, 0x9e, 0xb1, 0x82,
+	0xae, 0x13, 0xde, 0xc0, 0xe7, 0xfd, 0x04, 0xd3, 0x75, 0xbd, 0xcf, 0xae, 0x13, 0x7e, 0x12, 0x81,
+	0xf2, 0x62, 0x32, 0x55, 0x9f, 0x76, 0x61, 0x93, 0x52, 0x76, 0x47, 0x33, 0x5e, 0x4b, 0x6f, 0xa1,
+	0xdc, 0xe7, 0x2e, 0xbd, 0x6b, 0x3b, 0x18, 0x99, 0x23, 0xcb, 0x16, 0xf7, 0x14, 0x65, 0x32, 0x55,
+	0x9f, 0x75, 0xc1, 0x4b, 0x76, 0x4b, 0xaa, 0x02, 0x17, 0x31, 0x51, 0x1e, 0x7d, 0xfb, 0x81, 0x84,
+	0xa1, 0xfb, 0xb0, 0x40, 0x60, 0xb6, 0x40, 0xe0, 0xf7, 0x02, 0x81, 0xef, 0x4b, 0x24, 0xcc, 0x96,
+	0x48, 0xf8, 0xb5, 0x44, 0xc2, 0xcd, 0x45, 0x9a, 0xd5, 0x5f, 0x9a, 0xb1, 0x1e, 0xb3, 0xdc, 0xb0,
+	0x9a, 0x84, 0x5d, 0x93, 0xa2, 0x6e, 0x2a, 0xc2, 0x8d, 0xf6, 0x79, 0x5f, 0x15, 0x2c, 0x21, 0xc6,
+	0xd7, 0xbf, 0x53, 0x51, 0xdf, 0x97, 0x84, 0x8f, 0x0f, 0xda, 0xff, 0x7e, 0xfd, 0x67, 0x00, 0x58,
+	0xe2, 0xb3, 0x01, 0x33, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uploaders) > 0 {
+		for iNdEx := len(m.Uploaders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Uploaders[iNdEx])
+			copy(dAtA[i:], m.Uploaders[iNdEx])
+			i = encodeVarintWasmgov(dAtA, i, uint64(len(m.Uploaders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.UploadAccess != 0 {
+		i = encodeVarintWasmgov(dAtA, i, uint64(m.UploadAccess))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EnabledProposals) > 0 {
+		for iNdEx := len(m.EnabledProposals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnabledProposals[iNdEx])
+			copy(dAtA[i:], m.EnabledProposals[iNdEx])
+			i = encodeVarintWasmgov(dAtA, i, uint64(len(m.EnabledProposals[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWasmgov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWasmgov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EnabledProposals) > 0 {
+		for _, s := range m.EnabledProposals {
+			l = len(s)
+			n += 1 + l + sovWasmgov(uint64(l))
+		}
+	}
+	if m.UploadAccess != 0 {
+		n += 1 + sovWasmgov(uint64(m.UploadAccess))
+	}
+	if len(m.Uploaders) > 0 {
+		for _, s := range m.Uploaders {
+			l = len(s)
+			n += 1 + l + sovWasmgov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovWasmgov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWasmgov(x uint64) (n int) {
+	return sovWasmgov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmgov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledProposals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmgov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmgov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmgov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnabledProposals = append(m.EnabledProposals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadAccess", wireType)
+			}
+			m.UploadAccess = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmgov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadAccess |= UploadAccess(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploaders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmgov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmgov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmgov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploaders = append(m.Uploaders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmgov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWasmgov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWasmgov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWasmgov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWasmgov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWasmgov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWasmgov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWasmgov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWasmgov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWasmgov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWasmgov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWasmgov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWasmgov = fmt.Errorf("proto: unexpected end of group")
+)